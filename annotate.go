@@ -0,0 +1,58 @@
+package sequence
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/cookieo9/sequence/tools"
+)
+
+// previewLength is the maximum number of runes of an element's formatted value
+// included in an error annotation.
+const previewLength = 40
+
+// preview formats a value for inclusion in an error message, truncating it if
+// it's too long.
+func preview(v any) string {
+	r := []rune(fmt.Sprintf("%v", v))
+	if len(r) > previewLength {
+		return string(r[:previewLength-3]) + "..."
+	}
+	return string(r)
+}
+
+// AnnotateErrors returns a sequence that adds the position of the failing
+// element to errors leaving Each. When a downstream stage, such as the convert
+// function of a [MapErr], fails on an element, the error is annotated (via
+// [tools.Annotate]) with the index of that element and a short preview of its
+// value. Errors from the input sequence itself are annotated with the number
+// of elements seen before the failure.
+//
+// To get the most useful positions, place AnnotateErrors directly after the
+// source, so that the stages that might fail are downstream of it. The
+// original error is wrapped, so [errors.Is] and [errors.As] continue to work,
+// including for [ErrStopIteration].
+func AnnotateErrors[T any](s Sequence[T]) Sequence[T] {
+	return Derive(s, func(f func(T) error) error {
+		var n atomic.Int64
+		var fromCallback atomic.Bool
+		err := s.Each(func(t T) error {
+			i := n.Add(1) - 1
+			if err := f(t); err != nil {
+				fromCallback.Store(true)
+				return tools.Annotatef(err, "element %d (%s)", i, preview(t))
+			}
+			return nil
+		})
+		if err != nil && !fromCallback.Load() {
+			return tools.Annotatef(err, "after %d elements", n.Load())
+		}
+		return err
+	})
+}
+
+// AnnotateErrors is a helper method to call the package function
+// [AnnotateErrors] on the receiver.
+func (s Sequence[T]) AnnotateErrors() Sequence[T] {
+	return AnnotateErrors(s)
+}
@@ -0,0 +1,52 @@
+package sequence
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestAnnotateErrors(t *testing.T) {
+	t.Run("Callback", func(t *testing.T) {
+		input := New("1", "2", "three", "4").AnnotateErrors()
+		err := checkErrorSequence(t, MapErr(input, strconv.Atoi), strconv.ErrSyntax)
+		if want := `element 2 (three)`; !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q doesn't contain %q", err, want)
+		}
+	})
+
+	t.Run("Source", func(t *testing.T) {
+		bad := errors.New("bad source")
+		input := Concat(New(1, 2), Error[int](bad)).AnnotateErrors()
+		err := checkErrorSequence(t, input, bad)
+		if want := "after 2 elements"; !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q doesn't contain %q", err, want)
+		}
+	})
+
+	t.Run("Preview", func(t *testing.T) {
+		long := strings.Repeat("x", 100)
+		input := New(long).AnnotateErrors()
+		err := checkErrorSequence(t, MapErr(input, strconv.Atoi), strconv.ErrSyntax)
+		if want := "(" + long[:37] + "...)"; !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q doesn't contain %q", err, want)
+		}
+	})
+
+	t.Run("StopIteration", func(t *testing.T) {
+		input := Counter(0).AnnotateErrors()
+		err := input.Each(func(i int) error {
+			if i == 5 {
+				return ErrStopIteration
+			}
+			return nil
+		})
+		if !errors.Is(err, ErrStopIteration) {
+			t.Errorf("expected ErrStopIteration, got %v", err)
+		}
+		if err := Each(input)(func(i int) error { return ErrStopIteration }); err != nil {
+			t.Errorf("unexpected error from Each: %v", err)
+		}
+	})
+}
@@ -21,7 +21,13 @@ import (
 // Due to the unstable nature of results, async sequences are also marked as
 // volatile, meaning that they can only be iterated over once, and need to
 // be generated again from the input sequence, or the results need to be
-// materialized with [Materialize] or [extra.Buffer].
+// materialized with [Materialize] or [extra.Buffer]. For the same reason,
+// indexed operators such as [Enumerate] and [MapIndexed] refuse the output
+// of an async sequence; call Enumerate before AsyncPool instead to carry each
+// item's source index through the pool.
+//
+// A panic in a worker goroutine is recovered and converted into a
+// [*PanicError], which stops processing and is returned like any other error.
 //
 // Note: any state that is altered by a downstream operation, e.g. [Collect]
 // may produce unexpected results since the order is non-deterministic, and
@@ -36,7 +42,8 @@ func AsyncPool[T any](n int, s Sequence[T]) Sequence[T] {
 		grp.SetLimit(n)
 
 		err := s.Each(func(t T) error {
-			grp.Go(func() error {
+			grp.Go(func() (err error) {
+				defer catchPanic(&err)
 				return f(t)
 			})
 			return nil
@@ -45,6 +52,7 @@ func AsyncPool[T any](n int, s Sequence[T]) Sequence[T] {
 		return tools.Or(grp.Wait(), err)
 	})
 	s2.async = true
+	s2.unordered = true
 	return Volatile(s2)
 }
 
@@ -2,6 +2,7 @@ package sequence
 
 import (
 	"context"
+	"errors"
 )
 
 // FromChan produces a sequence from the provided channel. The new sequence is
@@ -62,7 +63,6 @@ func IntoChanPair[T any](ch chan<- Pair[T, error], s Sequence[T]) {
 func ToChan[T any](s Sequence[T]) <-chan T {
 	ch := make(chan T)
 	go func() {
-		defer close(ch)
 		if err := IntoChan(ch, s); err != nil {
 			panic(err)
 		}
@@ -72,13 +72,13 @@ func ToChan[T any](s Sequence[T]) <-chan T {
 
 // ToChanErr returns 2 channels, the first containing items from the sequence,
 // and the second will receive an error if the sequence failed with that error.
+// A panic while iterating the sequence is reported as a [*PanicError].
 func ToChanErr[T any](s Sequence[T]) (<-chan T, <-chan error) {
 	ch := make(chan T)
 	eCh := make(chan error)
 	go func() {
-		defer close(ch)
 		defer close(eCh)
-		if err := IntoChan(ch, s); err != nil {
+		if err := IntoChan(ch, Recover(s)); err != nil {
 			eCh <- err
 		}
 	}()
@@ -87,23 +87,57 @@ func ToChanErr[T any](s Sequence[T]) (<-chan T, <-chan error) {
 
 // ToChanPair returns a channel whose elements are Pair[T,error] such that
 // errors that arise while processing the sequence will return a <zero,err>
-// Pair.
+// Pair. A panic while iterating the sequence is reported as a [*PanicError].
 func ToChanPair[T any](s Sequence[T]) <-chan Pair[T, error] {
 	ch := make(chan Pair[T, error])
-	go IntoChanPair(ch, s)
+	go IntoChanPair(ch, Recover(s))
 	return ch
 }
 
 // ToChanCtx returns a channel and a context where the channel returns values
 // emitted from the input sequence, and the context will be cancelled if the
 // sequence returns an error. An input context is used as the basis of the
-// generated context, and can be used to cancel processing externally.
+// generated context, and can be used to cancel processing externally. A panic
+// while iterating the sequence cancels the context with a [*PanicError].
 func ToChanCtx[T any](ctx context.Context, s Sequence[T]) (<-chan T, context.Context) {
 	ch := make(chan T)
 	ctx, cncl := context.WithCancelCause(ctx)
 	go func() {
-		defer close(ch)
-		cncl(IntoChanCtx(ctx, ch, s))
+		cncl(IntoChanCtx(ctx, ch, Recover(s)))
 	}()
 	return ch, ctx
 }
+
+// feed iterates the sequence in a new goroutine, sending each item to the
+// returned channel as a [Result], followed by a Result holding the error
+// should iteration fail. The channel is closed once the sequence is done.
+// Closing the done channel makes the goroutine stop at the next item, so that
+// readers can abandon the returned channel without leaking the goroutine.
+func feed[T any](done <-chan struct{}, s Sequence[T]) <-chan Result[T] {
+	ch := make(chan Result[T])
+	go func() {
+		defer close(ch)
+		feedTo(done, ch, s)
+	}()
+	return ch
+}
+
+// feedTo is the body of [feed], sending the items of the sequence to the
+// given channel without closing it, so that it can be shared by several
+// sequences.
+func feedTo[T any](done <-chan struct{}, ch chan<- Result[T], s Sequence[T]) {
+	err := Recover(s).Each(func(t T) error {
+		select {
+		case ch <- ResultValue(t):
+			return nil
+		case <-done:
+			return ErrStopIteration
+		}
+	})
+	if err != nil && !errors.Is(err, ErrStopIteration) {
+		select {
+		case ch <- ResultError[T](err):
+		case <-done:
+		}
+	}
+}
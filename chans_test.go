@@ -0,0 +1,54 @@
+package sequence
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func drain[T any](ch <-chan T) []T {
+	var out []T
+	for x := range ch {
+		out = append(out, x)
+	}
+	return out
+}
+
+// The ToChan family used to close the output channel a second time after
+// IntoChan had already closed it, panicking in the producing goroutine.
+func TestToChanCloses(t *testing.T) {
+	want := []int{1, 2, 3}
+
+	t.Run("ToChan", func(t *testing.T) {
+		compareSequences(t, FromSlice(drain(ToChan(New(1, 2, 3)))), FromSlice(want))
+	})
+
+	t.Run("ToChanErr", func(t *testing.T) {
+		ch, eCh := ToChanErr(New(1, 2, 3))
+		compareSequences(t, FromSlice(drain(ch)), FromSlice(want))
+		if err := <-eCh; err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("ToChanCtx", func(t *testing.T) {
+		ch, ctx := ToChanCtx(context.Background(), New(1, 2, 3))
+		compareSequences(t, FromSlice(drain(ch)), FromSlice(want))
+		<-ctx.Done()
+		if err := context.Cause(ctx); !errors.Is(err, context.Canceled) {
+			t.Errorf("unexpected cause: %v", err)
+		}
+	})
+
+	t.Run("ToChanPair", func(t *testing.T) {
+		got := drain(ToChanPair(New(1, 2, 3)))
+		if len(got) != len(want) {
+			t.Fatalf("unexpected item count; got %d, want %d", len(got), len(want))
+		}
+		for i, p := range got {
+			if p.A() != want[i] || p.B() != nil {
+				t.Errorf("unexpected item %d; got %v", i, p)
+			}
+		}
+	})
+}
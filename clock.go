@@ -0,0 +1,118 @@
+package sequence
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// A Clock provides the current time and a way to wait for time to pass. It
+// allows time based sequences to be tested without actually waiting, by using
+// a [FakeClock] in place of [SystemClock].
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// Sleep blocks until the given duration has passed.
+	Sleep(d time.Duration)
+	// After returns a channel that receives the current time once the given
+	// duration has passed.
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) Sleep(d time.Duration)                  { time.Sleep(d) }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// SystemClock is a [Clock] using the real time functions of the time package.
+var SystemClock Clock = systemClock{}
+
+// A FakeClock is a deterministic [Clock] where time only moves forward when
+// Advance is called. Goroutines waiting on Sleep or After are woken once the
+// clock has been advanced past their deadline.
+//
+// A FakeClock must be created with [NewFakeClock].
+type FakeClock struct {
+	lock    sync.Mutex
+	cond    sync.Cond
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+type fakeWaiter struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+// NewFakeClock creates a [FakeClock] whose current time is start.
+func NewFakeClock(start time.Time) *FakeClock {
+	c := &FakeClock{now: start}
+	c.cond.L = &c.lock
+	return c
+}
+
+// Now returns the current time of the fake clock.
+func (c *FakeClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.now
+}
+
+// After returns a channel that receives the time once the clock has been
+// advanced by at least d. A duration <= 0 fires immediately.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, fakeWaiter{deadline: c.now.Add(d), ch: ch})
+	c.cond.Broadcast()
+	return ch
+}
+
+// Sleep blocks until the clock has been advanced by at least d.
+func (c *FakeClock) Sleep(d time.Duration) {
+	<-c.After(d)
+}
+
+// Advance moves the clock forward by d, waking any sleepers whose deadline
+// has been reached, in deadline order.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.now = c.now.Add(d)
+	sort.SliceStable(c.waiters, func(i, j int) bool {
+		return c.waiters[i].deadline.Before(c.waiters[j].deadline)
+	})
+	n := 0
+	for _, w := range c.waiters {
+		if w.deadline.After(c.now) {
+			break
+		}
+		w.ch <- c.now
+		n++
+	}
+	c.waiters = append(c.waiters[:0], c.waiters[n:]...)
+}
+
+// Waiters returns the number of pending Sleep or After calls.
+func (c *FakeClock) Waiters() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return len(c.waiters)
+}
+
+// BlockUntil waits until there are at least n pending Sleep or After calls.
+// Tests use it to know that the code under test is waiting on the clock
+// before calling Advance.
+func (c *FakeClock) BlockUntil(n int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for len(c.waiters) < n {
+		c.cond.Wait()
+	}
+}
@@ -0,0 +1,61 @@
+package sequence
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+// runWithClock calls run in a new goroutine, advancing the clock by step
+// whenever the goroutine is waiting on it, until run returns.
+func runWithClock(c *FakeClock, step time.Duration, run func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		run()
+	}()
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		if c.Waiters() > 0 {
+			c.Advance(step)
+		} else {
+			runtime.Gosched()
+		}
+	}
+}
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	c := NewFakeClock(start)
+
+	late := c.After(2 * time.Second)
+	early := c.After(time.Second)
+	c.BlockUntil(2)
+
+	c.Advance(500 * time.Millisecond)
+	select {
+	case <-early:
+		t.Fatal("timer fired early")
+	default:
+	}
+
+	c.Advance(500 * time.Millisecond)
+	if got, want := <-early, start.Add(time.Second); !got.Equal(want) {
+		t.Errorf("unexpected timer time; got %v, want %v", got, want)
+	}
+	if n := c.Waiters(); n != 1 {
+		t.Errorf("unexpected number of waiters; got %d, want 1", n)
+	}
+
+	c.Advance(time.Hour)
+	if got, want := <-late, start.Add(time.Hour+time.Second); !got.Equal(want) {
+		t.Errorf("unexpected timer time; got %v, want %v", got, want)
+	}
+	if got, want := c.Now(), start.Add(time.Hour+time.Second); !got.Equal(want) {
+		t.Errorf("unexpected clock time; got %v, want %v", got, want)
+	}
+}
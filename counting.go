@@ -24,24 +24,64 @@ func Counter[T tools.Arithmetic](start T) Sequence[T] {
 //
 // - If start >= stop, a 0-item sequence is produced.
 // - If step <= 0, an erroring sequnce is produced as it's likely a mistake.
+//
+// Sequences of integers are indexed, as long as their length fits in an int,
+// and end before the counter would overflow its type.
 func NumberSequence[T tools.Integer | tools.Real](start, stop, step T) Sequence[T] {
 	if step <= 0 {
 		return Error[T](fmt.Errorf("called NumberSequence with invalid step (%v <= 0)", step))
 	}
 
+	if n, ok := numberSequenceLen(start, stop, step); ok {
+		return GenerateIndexed(n, func(i int) T { return start + T(i)*step })
+	}
 	return Generate[T](func(f func(T) error) error {
-		for i := start; i < stop; i += step {
+		for i := start; i < stop; {
 			if err := f(i); err != nil {
 				return err
 			}
+			// Stop if the counter would wrap around the top of its type.
+			next := i + step
+			if next <= i {
+				return nil
+			}
+			i = next
 		}
 		return nil
 	})
 }
 
+// numberSequenceLen computes the length of an integer NumberSequence. It
+// returns false for floating point types, where accumulated rounding makes
+// the length unreliable, or if the length can't be computed without overflow.
+func numberSequenceLen[T tools.Integer | tools.Real](start, stop, step T) (int, bool) {
+	if T(1)/T(2) != 0 {
+		return 0, false
+	}
+	if start >= stop {
+		return 0, true
+	}
+	d := stop - start
+	if d <= 0 {
+		return 0, false
+	}
+	n := d / step
+	if n*step != d {
+		n++
+	}
+	if i := int(n); i >= 0 && T(i) == n {
+		return i, true
+	}
+	return 0, false
+}
+
 // Count returns a Result with the number of items in the given sequence,
-// or an error if one was produced while iterating the sequence.
+// or an error if one was produced while iterating the sequence. Indexed
+// sequences return their length without being iterated.
 func Count[T any](s Sequence[T]) Result[int] {
+	if n, ok := s.Len(); ok {
+		return ResultValue(n)
+	}
 	var n int
 	err := EachSimple(s)(func(t T) bool {
 		n++
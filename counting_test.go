@@ -58,3 +58,27 @@ func TestBadNumberSequences(t *testing.T) {
 	rev := NumberSequence(10, 0, -1)
 	_ = checkErrorSequence(t, rev, nil)
 }
+
+func TestNumberSequenceOverflow(t *testing.T) {
+	t.Parallel()
+
+	// The counter would wrap past the top of the type before reaching stop,
+	// so iteration must end where the length says it does.
+	i8 := NumberSequence[int8](100, 127, 10)
+	compareSequences(t, i8, New[int8](100, 110, 120))
+	if n := i8.Count().Value(); n != 3 {
+		t.Errorf("unexpected count; got %d, want 3", n)
+	}
+	compareSequences(t, Reverse(i8), New[int8](120, 110, 100))
+
+	u8 := NumberSequence[uint8](250, 255, 3)
+	compareSequences(t, u8, New[uint8](250, 253))
+
+	// A range too wide for the type's difference isn't indexed, but still
+	// stops before wrapping.
+	wide := NumberSequence[int8](-100, 127, 50)
+	if wide.IsIndexed() {
+		t.Error("expected an unindexed sequence")
+	}
+	compareSequences(t, wide, New[int8](-100, -50, 0, 50, 100))
+}
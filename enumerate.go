@@ -0,0 +1,92 @@
+package sequence
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnordered is returned by the indexed operators, such as [Enumerate],
+// when their input produces items in an order that depends on timing, like
+// the output of [AsyncPool] or [Merge]. Indices assigned to such items would
+// differ from one run to the next.
+var ErrUnordered = errors.New("sequence order isn't stable")
+
+// checkOrdered returns an error sequence if s is unordered, naming the
+// operator that refused it.
+func checkOrdered[Out, In any](name string, s Sequence[In]) (Sequence[Out], bool) {
+	if !s.unordered {
+		return Sequence[Out]{}, true
+	}
+	return Error[Out](fmt.Errorf("called %s on an unordered sequence (Enumerate before AsyncPool instead): %w", name, ErrUnordered)), false
+}
+
+// Enumerate returns a sequence of pairs, where the first item is the index of
+// the item from the input sequence, and the second item is the item itself.
+// It's like Zip(Counter(0), s), but the index is computed inline instead of
+// iterating a second sequence in other goroutines.
+//
+// Enumerating before an [AsyncPool] stage gives each item its index in the
+// source, which then travels with it through the pool. Enumerating the output
+// of AsyncPool or [Merge] fails with [ErrUnordered], since their order
+// changes from run to run.
+func Enumerate[T any](s Sequence[T]) Sequence[Pair[int, T]] {
+	if bad, ok := checkOrdered[Pair[int, T]]("Enumerate", s); !ok {
+		return bad
+	}
+	return mapIndexed(s, MakePair[int, T])
+}
+
+// MapIndexed is like [Map], but the convert function also receives the index
+// of each item, counting from zero on each iteration. Like [Enumerate], it
+// fails with [ErrUnordered] if the input's order isn't stable.
+func MapIndexed[In, Out any](s Sequence[In], convert func(int, In) Out) Sequence[Out] {
+	if bad, ok := checkOrdered[Out]("MapIndexed", s); !ok {
+		return bad
+	}
+	return mapIndexed(s, convert)
+}
+
+// mapIndexed is [MapIndexed] without the order check.
+func mapIndexed[In, Out any](s Sequence[In], convert func(int, In) Out) Sequence[Out] {
+	return Derive(s, func(f func(Out) error) error {
+		i := 0
+		return s.Each(func(in In) error {
+			out := convert(i, in)
+			i++
+			return f(out)
+		})
+	})
+}
+
+// FilterIndexed is like [Filter], but the predicate also receives the index of
+// each item in the input sequence, counting from zero on each iteration. Like
+// [Enumerate], it fails with [ErrUnordered] if the input's order isn't stable.
+func FilterIndexed[T any](s Sequence[T], pred func(int, T) bool) Sequence[T] {
+	if bad, ok := checkOrdered[T]("FilterIndexed", s); !ok {
+		return bad
+	}
+	return filterIndexed(s, pred)
+}
+
+// filterIndexed is [FilterIndexed] without the order check, for operators
+// like [StepBy] that only need positions in the order items arrive. The input
+// must not be async.
+func filterIndexed[T any](s Sequence[T], pred func(int, T) bool) Sequence[T] {
+	return Derive(s, func(f func(T) error) error {
+		i := 0
+		return s.Each(func(t T) error {
+			keep := pred(i, t)
+			i++
+			if keep {
+				return f(t)
+			}
+			return nil
+		})
+	})
+}
+
+// FilterIndexed is a helper method to call the package function
+// [FilterIndexed] on the receiver.
+func (s Sequence[T]) FilterIndexed(pred func(int, T) bool) Sequence[T] {
+	return FilterIndexed(s, pred)
+}
@@ -0,0 +1,71 @@
+package sequence
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestEnumerate(t *testing.T) {
+	seq := New("a", "b", "c")
+	want := New(MakePair(0, "a"), MakePair(1, "b"), MakePair(2, "c"))
+	opt := cmpopts.EquateComparable(Pair[int, string]{})
+
+	enum := Enumerate(seq)
+	compareSequences(t, enum, want, opt)
+	// Indices restart on each iteration.
+	compareSequences(t, enum, want, opt)
+	compareSequences(t, enum, Zip(Counter(0), seq), opt)
+}
+
+func TestIndexed(t *testing.T) {
+	seq := New(3, 1, 4, 1, 5, 9, 2, 6)
+
+	t.Run("MapIndexed", func(t *testing.T) {
+		got := MapIndexed(seq, func(i, x int) int { return i * x })
+		compareSequences(t, got, New(0, 1, 8, 3, 20, 45, 12, 42))
+	})
+
+	t.Run("FilterIndexed", func(t *testing.T) {
+		got := seq.FilterIndexed(func(i, _ int) bool { return i%3 == 0 })
+		compareSequences(t, got, New(3, 1, 2))
+	})
+
+	t.Run("Unordered", func(t *testing.T) {
+		async := NumberSequence(0, 10, 1).AsyncPool(4)
+		_ = checkErrorSequence(t, Enumerate(async), ErrUnordered)
+		_ = checkErrorSequence(t, MapIndexed(Map(async, func(x int) int { return x * 2 }), func(i, _ int) int { return i }), ErrUnordered)
+		_ = checkErrorSequence(t, FilterIndexed(async.Sync(), func(int, int) bool { return true }), ErrUnordered)
+		_ = checkErrorSequence(t, Enumerate(Merge(seq, seq)), ErrUnordered)
+	})
+}
+
+func TestEnumerateAsync(t *testing.T) {
+	// Enumerating before the pool gives every item its source index, however
+	// the workers reorder them.
+	n := 1000
+	src := Map(NumberSequence(0, n, 1), func(i int) int { return i * 7 })
+	for run := 0; run < 3; run++ {
+		pairs := Enumerate(src).AsyncPool(8)
+		var lock sync.Mutex
+		seen := map[int]int{}
+		err := Each(pairs)(func(p Pair[int, int]) error {
+			lock.Lock()
+			defer lock.Unlock()
+			seen[p.A()] = p.B()
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(seen) != n {
+			t.Errorf("run %d: got %d distinct indices, want %d", run, len(seen), n)
+		}
+		for i, v := range seen {
+			if v != i*7 {
+				t.Errorf("run %d: index %d holds %d, want its source item %d", run, i, v, i*7)
+			}
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package extra
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+
+	"github.com/cookieo9/sequence"
+	"github.com/cookieo9/sequence/tools"
+)
+
+// bzip2HeaderSize is the length of the "BZh" signature and block size digit
+// that start a bzip2 stream.
+const bzip2HeaderSize = 4
+
+var (
+	gzipMagic      = []byte{0x1f, 0x8b}
+	bzip2Block     = []byte("1AY&SY")
+	bzip2EndOfFile = []byte{0x17, 0x72, 0x45, 0x38, 0x50, 0x90}
+)
+
+// sniffSize is the number of bytes needed to recognize any of the supported
+// formats, which is set by the bzip2 header and the block magic after it.
+var sniffSize = bzip2HeaderSize + len(bzip2Block)
+
+// mayBeBzip2 reports whether header matches the start of a bzip2 stream as
+// far as it goes: "BZh" and a block size digit, followed by the magic number
+// of either a block or the end of the stream. The magic numbers are checked
+// too, since plain text could start with "BZh".
+func mayBeBzip2(header []byte) bool {
+	for i, b := range header[:min(len(header), bzip2HeaderSize)] {
+		if i < 3 && b != "BZh"[i] || i == 3 && (b < '1' || b > '9') {
+			return false
+		}
+	}
+	if len(header) <= bzip2HeaderSize {
+		return true
+	}
+	magic := header[bzip2HeaderSize:]
+	return bytes.HasPrefix(bzip2Block, magic) || bytes.HasPrefix(bzip2EndOfFile, magic)
+}
+
+// isBzip2 reports whether the header is that of a bzip2 stream.
+func isBzip2(header []byte) bool {
+	return len(header) >= sniffSize && mayBeBzip2(header[:sniffSize])
+}
+
+// sniff reads the start of r, stopping as soon as the bytes read rule out
+// every supported format or identify one, so that a live input, such as a
+// pipe, isn't held up waiting for more data than it has.
+func sniff(r io.Reader) ([]byte, error) {
+	header := make([]byte, 0, sniffSize)
+	for {
+		maybeGzip := len(header) < len(gzipMagic) && bytes.HasPrefix(gzipMagic, header)
+		if len(header) == sniffSize || !(maybeGzip || mayBeBzip2(header)) {
+			return header, nil
+		}
+		n, err := r.Read(header[len(header):cap(header)])
+		header = header[:len(header)+n]
+		if err == io.EOF {
+			return header, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Decompress returns a reader producing the decompressed contents of r if it
+// starts with the magic bytes of a gzip or bzip2 stream, and the contents of
+// r as they are otherwise. Data is decompressed as it's read. An error is
+// returned if r can't be read or has a corrupt gzip header.
+//
+// Only as many bytes as needed to tell the formats apart are read up front,
+// so plain text is passed on as soon as its first byte rules them out.
+func Decompress(r io.Reader) (io.Reader, error) {
+	header, err := sniff(r)
+	if err != nil {
+		return nil, err
+	}
+	src := io.MultiReader(bytes.NewReader(header), r)
+	switch {
+	case bytes.HasPrefix(header, gzipMagic):
+		return gzip.NewReader(src)
+	case isBzip2(header):
+		return bzip2.NewReader(src), nil
+	}
+	return src, nil
+}
+
+// lazyDecompress is a reader that passes its input through [Decompress] on
+// the first call to Read.
+type lazyDecompress struct {
+	r       io.Reader
+	started bool
+	err     error
+}
+
+// decompressing returns a reader producing the decompressed contents of r,
+// like [Decompress], but which doesn't read anything until it's first read
+// from. Sources use it so that detection happens during iteration, where an
+// error can be reported.
+func decompressing(r io.Reader) io.Reader {
+	return &lazyDecompress{r: r}
+}
+
+func (l *lazyDecompress) Read(p []byte) (int, error) {
+	if !l.started {
+		l.started = true
+		l.r, l.err = Decompress(l.r)
+	}
+	if l.err != nil {
+		return 0, l.err
+	}
+	return l.r.Read(p)
+}
+
+// Decompressed returns an OpenFunc that opens its input with open, and reads
+// it through [Decompress], so that compressed files are read transparently.
+// Closing the reader closes the underlying input.
+func Decompressed(open OpenFunc) OpenFunc {
+	return func() (io.ReadCloser, error) {
+		rc, err := open()
+		if err != nil {
+			return nil, err
+		}
+		r, err := Decompress(rc)
+		if err != nil {
+			rc.Close()
+			return nil, err
+		}
+		return struct {
+			io.Reader
+			io.Closer
+		}{r, rc}, nil
+	}
+}
+
+// JSONLinesFile returns a sequence of the values decoded from the named JSON
+// Lines file, as produced by [JSONLines]. Like [LinesFile], the file is opened
+// again for each iteration, and is decompressed if needed.
+func JSONLinesFile[T any](path string) sequence.Sequence[T] {
+	return Reopen(File(path), JSONLines[T])
+}
+
+// JSONArrayFile returns a sequence of the elements of the JSON array in the
+// named file, as produced by [JSONArray]. Like [LinesFile], the file is opened
+// again for each iteration, and is decompressed if needed.
+func JSONArrayFile[T any](path string) sequence.Sequence[T] {
+	return Reopen(File(path), JSONArray[T])
+}
+
+// WriteGzip calls write with a writer that gzip compresses what it's given
+// into w, such that any of the sinks, like [WriteLines] or [WriteJSONLines],
+// can produce compressed output. The compressed stream is completed once
+// write returns, and any error from write or from compressing is returned.
+func WriteGzip(w io.Writer, write func(io.Writer) error) error {
+	zw := gzip.NewWriter(w)
+	err := write(zw)
+	return tools.Or(err, zw.Close())
+}
@@ -0,0 +1,213 @@
+package extra
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cookieo9/sequence"
+	"github.com/google/go-cmp/cmp"
+)
+
+// bzip2Data is "one\ntwo\nthree\n" compressed with bzip2, since the standard
+// library can only decompress it.
+const bzip2Data = "\x42\x5a\x68\x39\x31\x41\x59\x26\x53\x59\x08\x7b\x7d\xd7\x00\x00" +
+	"\x04\xc1\x80\x00\x10\x02\x41\x94\x80\x20\x00\x31\x0c\x08\x21\xa3" +
+	"\xd4\xc8\x85\x47\x32\x38\xa8\xf1\x77\x24\x53\x85\x09\x00\x87\xb7" +
+	"\xdd\x70"
+
+func gzipData(t *testing.T, text string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := WriteGzip(&buf, func(w io.Writer) error {
+		_, err := io.WriteString(w, text)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestDecompress(t *testing.T) {
+	const plain = "one\ntwo\nthree\n"
+	testCases := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"Plain", plain, plain},
+		{"Empty", "", ""},
+		{"Short", "a", "a"},
+		{"PlainBZh", "BZh9 is not bzip2\n", "BZh9 is not bzip2\n"},
+		{"Gzip", gzipData(t, plain), plain},
+		{"Bzip2", bzip2Data, plain},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := Decompress(strings.NewReader(tc.input))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("unexpected contents; got %q, want %q", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("CorruptGzip", func(t *testing.T) {
+		if _, err := Decompress(strings.NewReader("\x1f\x8bxxxxxxxxxx")); !errors.Is(err, gzip.ErrHeader) {
+			t.Errorf("expected gzip.ErrHeader, got %v", err)
+		}
+	})
+}
+
+func TestCompressedFiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	for _, path := range []string{
+		write("plain.txt", "one\ntwo\nthree\n"),
+		write("lines.txt.gz", gzipData(t, "one\ntwo\nthree\n")),
+		write("lines.txt.bz2", bzip2Data),
+	} {
+		seq := LinesFile(path)
+		for i := 0; i < 2; i++ {
+			got, err := seq.ToSlice().Pair()
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", path, err)
+			}
+			if diff := cmp.Diff(got, []string{"one", "two", "three"}); diff != "" {
+				t.Errorf("%s: unexpected lines on iteration %d (-got, +want):\n%s", path, i, diff)
+			}
+		}
+	}
+
+	values := []record{{1, "a"}, {2, "b"}}
+	var buf bytes.Buffer
+	err := WriteGzip(&buf, func(w io.Writer) error {
+		return WriteJSONLines(w, sequence.FromSlice(values))
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	got, err := JSONLinesFile[record](write("records.jsonl.gz", buf.String())).ToSlice().Pair()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(got, values); diff != "" {
+		t.Errorf("unexpected values (-got, +want):\n%s", diff)
+	}
+}
+
+func TestWriteGzip(t *testing.T) {
+	errTest := errors.New("test error")
+	var buf bytes.Buffer
+	err := WriteGzip(&buf, func(w io.Writer) error {
+		return WriteLines(w, sequence.Error[int](errTest))
+	})
+	if !errors.Is(err, errTest) {
+		t.Errorf("expected %v, got %v", errTest, err)
+	}
+
+	err = WriteGzip(&failWriter{}, func(w io.Writer) error {
+		return WriteLines(w, sequence.FromSlice([]int{1, 2, 3}))
+	})
+	if !errors.Is(err, errWrite) {
+		t.Errorf("expected %v, got %v", errWrite, err)
+	}
+}
+
+func TestCompressedReaders(t *testing.T) {
+	want := []string{"one", "two", "three"}
+	for name, data := range map[string]string{
+		"Gzip":  gzipData(t, "one\ntwo\nthree\n"),
+		"Bzip2": bzip2Data,
+	} {
+		got, err := Lines(strings.NewReader(data)).ToSlice().Pair()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("%s: unexpected lines (-got, +want):\n%s", name, diff)
+		}
+	}
+
+	records := gzipData(t, "{\"id\":1,\"name\":\"a\"}\n")
+	got, err := JSONLines[record](strings.NewReader(records)).ToSlice().Pair()
+	if err != nil || len(got) != 1 || got[0] != (record{1, "a"}) {
+		t.Errorf("unexpected JSON Lines result; got %v, %v", got, err)
+	}
+
+	array := gzipData(t, "[1, 2, 3]")
+	ints, err := JSONArray[int](strings.NewReader(array)).ToSlice().Pair()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(ints, []int{1, 2, 3}); diff != "" {
+		t.Errorf("unexpected array elements (-got, +want):\n%s", diff)
+	}
+
+	path := filepath.Join(t.TempDir(), "array.json.gz")
+	if err := os.WriteFile(path, []byte(array), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	seq := JSONArrayFile[int](path)
+	for i := 0; i < 2; i++ {
+		ints, err := seq.ToSlice().Pair()
+		if err != nil {
+			t.Errorf("unexpected error on iteration %d: %v", i, err)
+		}
+		if diff := cmp.Diff(ints, []int{1, 2, 3}); diff != "" {
+			t.Errorf("unexpected file elements on iteration %d (-got, +want):\n%s", i, diff)
+		}
+	}
+
+	t.Run("CorruptGzip", func(t *testing.T) {
+		err := Lines(strings.NewReader("\x1f\x8bxxxxxxxxxx")).ToSlice().Error()
+		if !errors.Is(err, gzip.ErrHeader) {
+			t.Errorf("expected gzip.ErrHeader, got %v", err)
+		}
+	})
+}
+
+func TestDecompressLive(t *testing.T) {
+	// Detection mustn't wait for more input than a live source has sent once
+	// the first bytes rule out compression.
+	for _, first := range []string{"hi\n", "B\n", "BZh\n"} {
+		pr, pw := io.Pipe()
+		go pw.Write([]byte(first))
+
+		done := make(chan string)
+		go func() {
+			line, _ := sequence.First(Lines(pr)).Pair()
+			done <- line
+		}()
+		select {
+		case line := <-done:
+			if want := strings.TrimSuffix(first, "\n"); line != want {
+				t.Errorf("unexpected first line; got %q, want %q", line, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Errorf("Lines blocked waiting for more input after %q", first)
+		}
+		pw.Close()
+	}
+}
@@ -0,0 +1,227 @@
+package extra
+
+import (
+	"encoding"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/cookieo9/sequence"
+	"github.com/cookieo9/sequence/tools"
+)
+
+// CSVOptions controls the format used by the CSV sources and sinks. The zero
+// value (or a nil pointer) uses the defaults of the [encoding/csv] package,
+// which is comma separated records. Set Comma to '\t' for TSV.
+type CSVOptions struct {
+	// Comma is the field delimiter. Zero means ','.
+	Comma rune
+
+	// Comment, if not zero, is a character that starts a comment line when
+	// reading. It's ignored when writing.
+	Comment rune
+
+	// LazyQuotes allows quotes in unquoted fields and non-doubled quotes in
+	// quoted fields when reading.
+	LazyQuotes bool
+
+	// TrimLeadingSpace ignores leading white space in fields when reading.
+	TrimLeadingSpace bool
+}
+
+func (o *CSVOptions) reader(r io.Reader) *csv.Reader {
+	cr := csv.NewReader(r)
+	if o != nil {
+		if o.Comma != 0 {
+			cr.Comma = o.Comma
+		}
+		cr.Comment = o.Comment
+		cr.LazyQuotes = o.LazyQuotes
+		cr.TrimLeadingSpace = o.TrimLeadingSpace
+	}
+	return cr
+}
+
+func (o *CSVOptions) writer(w io.Writer) *csv.Writer {
+	cw := csv.NewWriter(w)
+	if o != nil && o.Comma != 0 {
+		cw.Comma = o.Comma
+	}
+	return cw
+}
+
+// csvRecords passes each record read by cr to f, stopping at the end of the
+// input or at the first error.
+func csvRecords(cr *csv.Reader, f func([]string) error) error {
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := f(rec); err != nil {
+			return err
+		}
+	}
+}
+
+// ReadCSV returns a sequence of the records read from r. The options may be
+// nil to use the defaults. Every record must have the same number of fields as
+// the first one. Malformed input fails with a [*csv.ParseError] holding the
+// line and column of the problem.
+//
+// The sequence is Volatile, since the reader can only be consumed once. Use
+// [Reopen] to read a file more than once.
+func ReadCSV(r io.Reader, opts *CSVOptions) sequence.Sequence[[]string] {
+	cr := opts.reader(r)
+	return sequence.GenerateVolatile(func(f func([]string) error) error {
+		return csvRecords(cr, f)
+	})
+}
+
+// ReadCSVRows returns a sequence of the rows read from r, keyed by the column
+// names given in the first record, which isn't produced itself. Otherwise it
+// behaves like [ReadCSV].
+func ReadCSVRows(r io.Reader, opts *CSVOptions) sequence.Sequence[map[string]string] {
+	cr := opts.reader(r)
+	return sequence.GenerateVolatile(func(f func(map[string]string) error) error {
+		var header []string
+		return csvRecords(cr, func(rec []string) error {
+			if header == nil {
+				header = rec
+				return nil
+			}
+			row := make(map[string]string, len(header))
+			for i, name := range header {
+				row[name] = rec[i]
+			}
+			return f(row)
+		})
+	})
+}
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// csvField returns the column name used for a struct field, or "" if the field
+// isn't decoded.
+func csvField(sf reflect.StructField) string {
+	if !sf.IsExported() {
+		return ""
+	}
+	name, ok := sf.Tag.Lookup("csv")
+	if !ok || name == "" {
+		return sf.Name
+	}
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// setCSVField parses text into v according to its type.
+func setCSVField(v reflect.Value, text string) error {
+	if reflect.PointerTo(v.Type()).Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(text)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(strings.TrimPrefix(text, "+"), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		x, err := strconv.ParseFloat(text, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(x)
+	default:
+		return fmt.Errorf("unsupported field type %v", v.Type())
+	}
+	return nil
+}
+
+// DecodeCSV returns a sequence of structs of type T decoded from the rows read
+// from r, where the first record names the columns. Each column is stored in
+// the exported field with a matching `csv:"name"` tag, or with the same name
+// if the field has no tag. Fields tagged `csv:"-"`, and columns without a
+// matching field, are ignored.
+//
+// Fields may be strings, bools, decimal integers, floats, or types implementing
+// [encoding.TextUnmarshaler]. A field that fails to parse produces a
+// [*csv.ParseError] with the line and column of the offending value.
+// Otherwise the sequence behaves like [ReadCSV].
+func DecodeCSV[T any](r io.Reader, opts *CSVOptions) sequence.Sequence[T] {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() != reflect.Struct {
+		return sequence.Error[T](fmt.Errorf("called DecodeCSV with non-struct type %v", typ))
+	}
+	fields := map[string]int{}
+	for i := 0; i < typ.NumField(); i++ {
+		if name := csvField(typ.Field(i)); name != "" {
+			fields[name] = i
+		}
+	}
+
+	cr := opts.reader(r)
+	return sequence.GenerateVolatile(func(f func(T) error) error {
+		var columns []int
+		return csvRecords(cr, func(rec []string) error {
+			if columns == nil {
+				columns = make([]int, len(rec))
+				for i, name := range rec {
+					idx, ok := fields[name]
+					columns[i] = tools.Pick(ok, idx, -1)
+				}
+				return nil
+			}
+			var t T
+			v := reflect.ValueOf(&t).Elem()
+			for i, idx := range columns {
+				if idx < 0 {
+					continue
+				}
+				if err := setCSVField(v.Field(idx), rec[i]); err != nil {
+					line, col := cr.FieldPos(i)
+					return &csv.ParseError{
+						StartLine: line,
+						Line:      line,
+						Column:    col,
+						Err:       fmt.Errorf("field %s: %w", typ.Field(idx).Name, err),
+					}
+				}
+			}
+			return f(t)
+		})
+	})
+}
+
+// WriteCSV writes the records of the sequence to w, using the given options,
+// which may be nil to use the defaults. Errors from the sequence or from
+// writing stop the process and are returned.
+func WriteCSV(w io.Writer, s sequence.Sequence[[]string], opts *CSVOptions) error {
+	cw := opts.writer(w)
+	err := sequence.Each(s.Sync())(cw.Write)
+	cw.Flush()
+	return tools.Or(err, cw.Error())
+}
@@ -0,0 +1,149 @@
+package extra
+
+import (
+	"encoding/csv"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cookieo9/sequence"
+	"github.com/google/go-cmp/cmp"
+)
+
+const people = `name,age,joined
+alice,30,2020-01-02T00:00:00Z
+bob,x,2021-03-04T00:00:00Z
+`
+
+func TestReadCSV(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+		opts  *CSVOptions
+		want  [][]string
+	}{
+		{"Empty", "", nil, nil},
+		{"Simple", "a,b\n1,2\n", nil, [][]string{{"a", "b"}, {"1", "2"}}},
+		{"Quoted", "\"a,b\",\"c\"\"d\"\n", nil, [][]string{{"a,b", "c\"d"}}},
+		{"TSV", "a\tb\n1\t2\n", &CSVOptions{Comma: '\t'}, [][]string{{"a", "b"}, {"1", "2"}}},
+		{"Comment", "# note\na,b\n", &CSVOptions{Comment: '#'}, [][]string{{"a", "b"}}},
+		{"TrimLeadingSpace", "a,  b\n", &CSVOptions{TrimLeadingSpace: true}, [][]string{{"a", "b"}}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ReadCSV(strings.NewReader(tc.input), tc.opts).ToSlice().Pair()
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected records (-got, +want):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("ParseError", func(t *testing.T) {
+		err := sequence.Each(ReadCSV(strings.NewReader("a,b\n1,2,3\n"), nil))(func([]string) error { return nil })
+		var pe *csv.ParseError
+		if !errors.As(err, &pe) {
+			t.Fatalf("expected *csv.ParseError, got %v", err)
+		}
+		if pe.Line != 2 {
+			t.Errorf("unexpected error line; got %d, want 2", pe.Line)
+		}
+	})
+}
+
+func TestReadCSVRows(t *testing.T) {
+	got, err := ReadCSVRows(strings.NewReader("x,y\n1,2\n3,4\n"), nil).ToSlice().Pair()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	want := []map[string]string{{"x": "1", "y": "2"}, {"x": "3", "y": "4"}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected rows (-got, +want):\n%s", diff)
+	}
+}
+
+type person struct {
+	Name    string    `csv:"name"`
+	Age     int       `csv:"age"`
+	Joined  time.Time `csv:"joined"`
+	Ignored string    `csv:"-"`
+}
+
+func TestDecodeCSV(t *testing.T) {
+	seq := DecodeCSV[person](strings.NewReader(people), nil)
+	var got []person
+	err := sequence.Each(seq)(func(p person) error {
+		got = append(got, p)
+		return nil
+	})
+
+	want := []person{{Name: "alice", Age: 30, Joined: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected values (-got, +want):\n%s", diff)
+	}
+
+	var pe *csv.ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected *csv.ParseError, got %v", err)
+	}
+	if pe.Line != 3 || pe.Column != 5 {
+		t.Errorf("unexpected error position; got %d:%d, want 3:5", pe.Line, pe.Column)
+	}
+
+	t.Run("Decimal", func(t *testing.T) {
+		type date struct {
+			Month int
+			Day   uint
+		}
+		got, err := DecodeCSV[date](strings.NewReader("Month,Day\n07,010\n08,+09\n"), nil).ToSlice().Pair()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(got, []date{{7, 10}, {8, 9}}); diff != "" {
+			t.Errorf("unexpected values (-got, +want):\n%s", diff)
+		}
+
+		for _, text := range []string{"0x10", "1_000"} {
+			err := sequence.Each(DecodeCSV[date](strings.NewReader("Month\n"+text+"\n"), nil))(func(date) error { return nil })
+			if !errors.Is(err, strconv.ErrSyntax) {
+				t.Errorf("decoding %q; got error %v, want %v", text, err, strconv.ErrSyntax)
+			}
+		}
+	})
+
+	t.Run("NonStruct", func(t *testing.T) {
+		err := sequence.Each(DecodeCSV[int](strings.NewReader(people), nil))(func(int) error { return nil })
+		if err == nil {
+			t.Error("expected error decoding into a non-struct type")
+		}
+	})
+}
+
+func TestWriteCSV(t *testing.T) {
+	records := [][]string{{"a", "b,c"}, {"1", "2"}}
+	var sb strings.Builder
+	if err := WriteCSV(&sb, sequence.FromSlice(records), nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if want := "a,\"b,c\"\n1,2\n"; sb.String() != want {
+		t.Errorf("unexpected output; got %q, want %q", sb.String(), want)
+	}
+
+	got := ReadCSV(strings.NewReader(sb.String()), nil).ToSlice().Value()
+	if diff := cmp.Diff(got, records); diff != "" {
+		t.Errorf("round trip failed (-got, +want):\n%s", diff)
+	}
+
+	sb.Reset()
+	if err := WriteCSV(&sb, sequence.FromSlice(records[1:]), &CSVOptions{Comma: '\t'}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if want := "1\t2\n"; sb.String() != want {
+		t.Errorf("unexpected TSV output; got %q, want %q", sb.String(), want)
+	}
+}
@@ -0,0 +1,194 @@
+package extra
+
+import (
+	"fmt"
+
+	"github.com/cookieo9/sequence"
+	"github.com/cookieo9/sequence/tools"
+)
+
+// A Point is a position on a [Grid], or an offset between positions. X grows
+// to the right and Y grows downwards, matching the order lines are read in.
+type Point struct {
+	X, Y int
+}
+
+// The unit offsets towards each neighbour of a point.
+var (
+	Up        = Point{0, -1}
+	Down      = Point{0, 1}
+	Left      = Point{-1, 0}
+	Right     = Point{1, 0}
+	UpLeft    = Up.Add(Left)
+	UpRight   = Up.Add(Right)
+	DownLeft  = Down.Add(Left)
+	DownRight = Down.Add(Right)
+)
+
+// Directions4 holds the offsets to the orthogonal neighbours of a point, in
+// clockwise order starting from Up.
+var Directions4 = []Point{Up, Right, Down, Left}
+
+// Directions8 holds the offsets to the orthogonal and diagonal neighbours of
+// a point, in clockwise order starting from Up.
+var Directions8 = []Point{Up, UpRight, Right, DownRight, Down, DownLeft, Left, UpLeft}
+
+// Add returns the point offset by q.
+func (p Point) Add(q Point) Point { return Point{p.X + q.X, p.Y + q.Y} }
+
+// Sub returns the offset from q to p.
+func (p Point) Sub(q Point) Point { return Point{p.X - q.X, p.Y - q.Y} }
+
+// Mul returns the point scaled by k.
+func (p Point) Mul(k int) Point { return Point{p.X * k, p.Y * k} }
+
+// String returns a string representation of the point.
+func (p Point) String() string {
+	return fmt.Sprintf("(%d,%d)", p.X, p.Y)
+}
+
+// A Grid is a rectangular, two dimensional array of cells, such as a map read
+// from a puzzle input. Cells are addressed by [Point], with (0,0) at the top
+// left.
+//
+// The sequences returned by a grid's methods read the cells when they're
+// iterated, so they reflect changes made with Set.
+type Grid[T any] struct {
+	width, height int
+	cells         []T
+}
+
+// NewGrid creates a grid of the given size, with every cell holding the zero
+// value of T.
+func NewGrid[T any](width, height int) *Grid[T] {
+	width, height = max(width, 0), max(height, 0)
+	return &Grid[T]{width: width, height: height, cells: make([]T, width*height)}
+}
+
+// ParseGrid builds a grid from a sequence of lines, such as those produced by
+// [Lines], where each rune of a line is converted to a cell by calling cell
+// with its position. Every line must have the same number of runes. An error
+// from cell, from the sequence, or caused by a line of the wrong length is
+// returned in the Result.
+func ParseGrid[T any](lines sequence.Sequence[string], cell func(Point, rune) (T, error)) sequence.Result[*Grid[T]] {
+	g := &Grid[T]{}
+	err := sequence.Each(lines.Sync())(func(line string) error {
+		y := g.height
+		row := []rune(line)
+		if y == 0 {
+			g.width = len(row)
+		} else if len(row) != g.width {
+			return fmt.Errorf("grid line %d has %d cells, want %d", y+1, len(row), g.width)
+		}
+		for x, r := range row {
+			p := Point{x, y}
+			v, err := cell(p, r)
+			if err != nil {
+				return tools.Annotatef(err, "grid cell %v", p)
+			}
+			g.cells = append(g.cells, v)
+		}
+		g.height++
+		return nil
+	})
+	return sequence.MakeResult(g, err)
+}
+
+// RuneGrid is a cell parser for [ParseGrid] that keeps the runes as they are.
+func RuneGrid(_ Point, r rune) (rune, error) { return r, nil }
+
+// Width returns the number of columns in the grid.
+func (g *Grid[T]) Width() int { return g.width }
+
+// Height returns the number of rows in the grid.
+func (g *Grid[T]) Height() int { return g.height }
+
+// In returns true if p is a position inside the grid.
+func (g *Grid[T]) In(p Point) bool {
+	return p.X >= 0 && p.X < g.width && p.Y >= 0 && p.Y < g.height
+}
+
+// Get returns the value of the cell at p. If p is outside the grid, a zero
+// value and false are returned.
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	if !g.In(p) {
+		return *new(T), false
+	}
+	return g.cells[p.Y*g.width+p.X], true
+}
+
+// Set stores v in the cell at p. It panics if p is outside the grid.
+func (g *Grid[T]) Set(p Point, v T) {
+	if !g.In(p) {
+		panic(fmt.Sprintf("grid position %v out of range [%dx%d]", p, g.width, g.height))
+	}
+	g.cells[p.Y*g.width+p.X] = v
+}
+
+func (g *Grid[T]) point(i int) Point {
+	return Point{i % g.width, i / g.width}
+}
+
+// Points returns a sequence of the positions of the cells of the grid, row by
+// row.
+func (g *Grid[T]) Points() sequence.Sequence[Point] {
+	return sequence.GenerateIndexed(len(g.cells), g.point)
+}
+
+// Cells returns a sequence pairing the position of each cell of the grid with
+// its value, row by row.
+func (g *Grid[T]) Cells() sequence.Sequence[sequence.Pair[Point, T]] {
+	return sequence.GenerateIndexed(len(g.cells), func(i int) sequence.Pair[Point, T] {
+		return sequence.MakePair(g.point(i), g.cells[i])
+	})
+}
+
+// Rows returns a sequence of the rows of the grid, from top to bottom. Each
+// row shares its storage with the grid, so it must not be modified.
+func (g *Grid[T]) Rows() sequence.Sequence[[]T] {
+	return sequence.GenerateIndexed(g.height, func(y int) []T {
+		start := y * g.width
+		return g.cells[start : start+g.width : start+g.width]
+	})
+}
+
+// Cols returns a sequence of the columns of the grid, from left to right.
+// Each column is a new slice, holding the cells from top to bottom.
+func (g *Grid[T]) Cols() sequence.Sequence[[]T] {
+	return sequence.GenerateIndexed(g.width, func(x int) []T {
+		col := make([]T, g.height)
+		for y := range col {
+			col[y] = g.cells[y*g.width+x]
+		}
+		return col
+	})
+}
+
+// neighbors returns a sequence of the positions reached from p by each of the
+// offsets that fall inside the grid.
+func (g *Grid[T]) neighbors(p Point, offsets []Point) sequence.Sequence[Point] {
+	return sequence.Filter(
+		sequence.Map(sequence.FromSlice(offsets), p.Add),
+		g.In,
+	)
+}
+
+// Neighbors4 returns a sequence of the positions orthogonally adjacent to p
+// that are inside the grid, in the order of [Directions4].
+func (g *Grid[T]) Neighbors4(p Point) sequence.Sequence[Point] {
+	return g.neighbors(p, Directions4)
+}
+
+// Neighbors8 returns a sequence of the positions orthogonally or diagonally
+// adjacent to p that are inside the grid, in the order of [Directions8].
+func (g *Grid[T]) Neighbors8(p Point) sequence.Sequence[Point] {
+	return g.neighbors(p, Directions8)
+}
+
+// Find returns a sequence of the positions of the cells whose value satisfies
+// pred, row by row.
+func (g *Grid[T]) Find(pred func(T) bool) sequence.Sequence[Point] {
+	return sequence.Filter(g.Points(), func(p Point) bool {
+		return pred(g.cells[p.Y*g.width+p.X])
+	})
+}
@@ -0,0 +1,126 @@
+package extra
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cookieo9/sequence"
+	"github.com/google/go-cmp/cmp"
+)
+
+const maze = `#.##
+#..#
+##.#`
+
+func parseMaze(t *testing.T) *Grid[rune] {
+	t.Helper()
+	g, err := ParseGrid(Lines(strings.NewReader(maze)), RuneGrid).Pair()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return g
+}
+
+func TestPoint(t *testing.T) {
+	p := Point{2, 3}
+	if got := p.Add(Right).Add(Down.Mul(2)); got != (Point{3, 5}) {
+		t.Errorf("unexpected sum; got %v, want (3,5)", got)
+	}
+	if got := p.Sub(Point{1, 1}); got != (Point{1, 2}) {
+		t.Errorf("unexpected difference; got %v, want (1,2)", got)
+	}
+	if got := UpLeft.String(); got != "(-1,-1)" {
+		t.Errorf("unexpected string; got %q, want %q", got, "(-1,-1)")
+	}
+}
+
+func TestParseGrid(t *testing.T) {
+	g := parseMaze(t)
+	if g.Width() != 4 || g.Height() != 3 {
+		t.Errorf("unexpected size; got %dx%d, want 4x3", g.Width(), g.Height())
+	}
+	if v, ok := g.Get(Point{2, 2}); !ok || v != '.' {
+		t.Errorf("unexpected cell (2,2); got %q, %v", v, ok)
+	}
+	if _, ok := g.Get(Point{4, 0}); ok {
+		t.Error("Get succeeded outside the grid")
+	}
+
+	t.Run("Ragged", func(t *testing.T) {
+		err := ParseGrid(sequence.FromSlice([]string{"ab", "abc"}), RuneGrid).Error()
+		if err == nil || !strings.Contains(err.Error(), "line 2") {
+			t.Errorf("expected error on line 2, got %v", err)
+		}
+	})
+
+	t.Run("CellError", func(t *testing.T) {
+		errTest := errors.New("test error")
+		digit := func(p Point, r rune) (int, error) {
+			if r < '0' || r > '9' {
+				return 0, errTest
+			}
+			return int(r - '0'), nil
+		}
+		err := ParseGrid(sequence.FromSlice([]string{"12", "3x"}), digit).Error()
+		if !errors.Is(err, errTest) || !strings.Contains(err.Error(), "(1,1)") {
+			t.Errorf("expected annotated error at (1,1), got %v", err)
+		}
+	})
+}
+
+func TestGridSequences(t *testing.T) {
+	g := parseMaze(t)
+
+	rows := sequence.Map(g.Rows(), func(r []rune) string { return string(r) }).ToSlice().Value()
+	if diff := cmp.Diff(rows, strings.Split(maze, "\n")); diff != "" {
+		t.Errorf("unexpected rows (-got, +want):\n%s", diff)
+	}
+
+	cols := sequence.Map(g.Cols(), func(c []rune) string { return string(c) }).ToSlice().Value()
+	if diff := cmp.Diff(cols, []string{"###", "..#", "#..", "###"}); diff != "" {
+		t.Errorf("unexpected columns (-got, +want):\n%s", diff)
+	}
+
+	if n, _ := g.Cells().Len(); n != 12 {
+		t.Errorf("unexpected cell count; got %d, want 12", n)
+	}
+	open := sequence.Filter(g.Cells(), func(c sequence.Pair[Point, rune]) bool { return c.B() == '.' })
+	if n := sequence.Count(open).Value(); n != 4 {
+		t.Errorf("unexpected open cell count; got %d, want 4", n)
+	}
+
+	isOpen := func(r rune) bool { return r == '.' }
+	found := g.Find(isOpen).ToSlice().Value()
+	if diff := cmp.Diff(found, []Point{{1, 0}, {1, 1}, {2, 1}, {2, 2}}); diff != "" {
+		t.Errorf("unexpected open cells (-got, +want):\n%s", diff)
+	}
+
+	g.Set(Point{0, 0}, '.')
+	if n := sequence.Count(g.Find(isOpen)).Value(); n != 5 {
+		t.Errorf("Set not reflected by Find; got %d open cells, want 5", n)
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g := NewGrid[int](3, 2)
+	testCases := []struct {
+		name string
+		seq  sequence.Sequence[Point]
+		want []Point
+	}{
+		{"Neighbors4Corner", g.Neighbors4(Point{0, 0}), []Point{{1, 0}, {0, 1}}},
+		{"Neighbors4Edge", g.Neighbors4(Point{1, 1}), []Point{{1, 0}, {2, 1}, {0, 1}}},
+		{"Neighbors8Corner", g.Neighbors8(Point{2, 1}), []Point{{2, 0}, {1, 1}, {1, 0}}},
+		{"Neighbors8Edge", g.Neighbors8(Point{1, 0}), []Point{{2, 0}, {2, 1}, {1, 1}, {0, 1}, {0, 0}}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.seq.ToSlice().Value()
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected neighbours (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package extra
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/cookieo9/sequence"
+	"github.com/cookieo9/sequence/tools"
+)
+
+// JSONLines returns a sequence of the values decoded from r, which holds one
+// JSON document per line (JSON Lines, or NDJSON). Lines are decoded as they
+// are reached, and blank lines are skipped. Lines aren't limited in length.
+//
+// Input compressed with gzip or bzip2 is detected from its first bytes and
+// decompressed as it's read (see [Decompress]).
+//
+// Decoding errors are annotated with the line number they occurred on, and
+// stop the iteration. The sequence is Volatile, since the reader can only be
+// consumed once.
+func JSONLines[T any](r io.Reader) sequence.Sequence[T] {
+	br := bufio.NewReader(decompressing(r))
+	line := 0
+	return sequence.GenerateVolatile(func(f func(T) error) error {
+		for {
+			data, err := br.ReadBytes('\n')
+			if len(data) > 0 {
+				line++
+				if len(bytes.TrimSpace(data)) > 0 {
+					var t T
+					if err := json.Unmarshal(data, &t); err != nil {
+						return tools.Annotatef(err, "line %d", line)
+					}
+					if err := f(t); err != nil {
+						return err
+					}
+				}
+			}
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	})
+}
+
+// WriteJSONLines writes the items of the sequence to w as JSON Lines, one
+// encoded value per line. Output is buffered, and errors from the sequence,
+// from encoding, or from writing stop the process and are returned.
+func WriteJSONLines[T any](w io.Writer, s sequence.Sequence[T]) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	err := sequence.Each(s.Sync())(func(t T) error {
+		return enc.Encode(t)
+	})
+	return tools.Or(err, bw.Flush())
+}
+
+// JSONArray returns a sequence of the elements of a JSON array read from r,
+// decoding them one at a time with a [json.Decoder], so that the whole array
+// never has to be held in memory. The input must hold a single top-level
+// array, apart from white space; anything else, including data after the
+// array, fails. Like [JSONLines], compressed input is decompressed.
+//
+// Decoding errors are annotated with the index of the element and its byte
+// offset in the input, and stop the iteration. The sequence is Volatile,
+// since the reader can only be consumed once.
+func JSONArray[T any](r io.Reader) sequence.Sequence[T] {
+	dec := json.NewDecoder(decompressing(r))
+	return sequence.GenerateVolatile(func(f func(T) error) error {
+		tok, err := dec.Token()
+		if err != nil {
+			return tools.Annotate(err, "reading JSON array")
+		}
+		if tok != json.Delim('[') {
+			return fmt.Errorf("expected JSON array, found %v at offset %d", tok, dec.InputOffset())
+		}
+		for index := 0; dec.More(); index++ {
+			var t T
+			offset := dec.InputOffset()
+			if err := dec.Decode(&t); err != nil {
+				return tools.Annotatef(err, "element %d at offset %d", index, offset)
+			}
+			if err := f(t); err != nil {
+				return err
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return tools.Annotate(err, "reading JSON array")
+		}
+
+		offset := dec.InputOffset()
+		switch tok, err := dec.Token(); {
+		case err == io.EOF:
+			return nil
+		case err == nil:
+			return fmt.Errorf("unexpected %v after JSON array at offset %d", tok, offset)
+		default:
+			return tools.Annotatef(err, "after JSON array at offset %d", offset)
+		}
+	})
+}
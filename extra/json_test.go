@@ -0,0 +1,112 @@
+package extra
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cookieo9/sequence"
+	"github.com/google/go-cmp/cmp"
+)
+
+type record struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestJSONLines(t *testing.T) {
+	input := "{\"id\":1,\"name\":\"a\"}\n\n  \n{\"id\":2,\"name\":\"b\"}"
+	got, err := JSONLines[record](strings.NewReader(input)).ToSlice().Pair()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	want := []record{{1, "a"}, {2, "b"}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected values (-got, +want):\n%s", diff)
+	}
+
+	t.Run("Error", func(t *testing.T) {
+		input := "{\"id\":1}\n\n{\"id\":\"x\"}\n{\"id\":3}\n"
+		var ids []int
+		err := sequence.Each(JSONLines[record](strings.NewReader(input)))(func(r record) error {
+			ids = append(ids, r.ID)
+			return nil
+		})
+		if err == nil || !strings.Contains(err.Error(), "line 3") {
+			t.Errorf("expected error on line 3, got %v", err)
+		}
+		if diff := cmp.Diff(ids, []int{1}); diff != "" {
+			t.Errorf("unexpected values before error (-got, +want):\n%s", diff)
+		}
+	})
+}
+
+func TestWriteJSONLines(t *testing.T) {
+	values := []record{{1, "a"}, {2, "b"}}
+	var sb strings.Builder
+	if err := WriteJSONLines(&sb, sequence.FromSlice(values)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if want := "{\"id\":1,\"name\":\"a\"}\n{\"id\":2,\"name\":\"b\"}\n"; sb.String() != want {
+		t.Errorf("unexpected output; got %q, want %q", sb.String(), want)
+	}
+
+	got := JSONLines[record](strings.NewReader(sb.String())).ToSlice().Value()
+	if diff := cmp.Diff(got, values); diff != "" {
+		t.Errorf("round trip failed (-got, +want):\n%s", diff)
+	}
+
+	errTest := errors.New("test error")
+	err := WriteJSONLines(&sb, sequence.Error[record](errTest))
+	if !errors.Is(err, errTest) {
+		t.Errorf("expected %v, got %v", errTest, err)
+	}
+}
+
+func TestJSONArray(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+		want  []int
+		fail  bool
+	}{
+		{"Empty", "[]", nil, false},
+		{"Values", " [1, 2,\n 3] ", []int{1, 2, 3}, false},
+		{"NotArray", "{\"a\": 1}", nil, true},
+		{"BadElement", "[1, \"two\", 3]", []int{1}, true},
+		{"Truncated", "[1, 2", []int{1, 2}, true},
+		{"Blank", "", nil, true},
+		{"TrailingSpace", "[1]\n\n", []int{1}, false},
+		{"TrailingJunk", "[1, 2] junk", []int{1, 2}, true},
+		{"TrailingValue", "[1] [2]", []int{1}, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var got []int
+			err := sequence.Each(JSONArray[int](strings.NewReader(tc.input)))(func(i int) error {
+				got = append(got, i)
+				return nil
+			})
+			if (err != nil) != tc.fail {
+				t.Errorf("unexpected error result; got %v, want failure %v", err, tc.fail)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected values (-got, +want):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("Lazy", func(t *testing.T) {
+		pr, pw := io.Pipe()
+		go func() {
+			pw.Write([]byte("[1, 2, "))
+			pw.CloseWithError(errors.New("never finished"))
+		}()
+		first, err := sequence.First(JSONArray[int](pr)).Pair()
+		if err != nil || first != 1 {
+			t.Errorf("unexpected first element; got %v, %v, want 1", first, err)
+		}
+	})
+}
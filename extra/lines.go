@@ -16,14 +16,12 @@ import (
 //
 // If you need to use the lines multiple times, consider calling Materialize on
 // the returned sequence, or wrap it with [process.Buffer].
+//
+// Lines are limited to 64KiB, use [Scan] with [bufio.ScanLines] and a larger
+// [ScanOptions.MaxTokenSize] for longer lines.
+//
+// Input compressed with gzip or bzip2 is detected from its first bytes and
+// decompressed as it's read (see [Decompress]).
 func Lines(r io.Reader) sequence.Sequence[string] {
-	scn := bufio.NewScanner(r)
-	return sequence.GenerateVolatile(func(f func(string) error) error {
-		for scn.Scan() {
-			if err := f(scn.Text()); err != nil {
-				return err
-			}
-		}
-		return scn.Err()
-	})
+	return Scan(decompressing(r), bufio.ScanLines, nil)
 }
@@ -0,0 +1,45 @@
+package extra
+
+import (
+	"io"
+	"os"
+
+	"github.com/cookieo9/sequence"
+	"github.com/cookieo9/sequence/tools"
+)
+
+// An OpenFunc opens a fresh reader for some input every time it's called,
+// such as a file by path. Sources built on one with [Reopen] can be iterated
+// multiple times, since they don't depend on the state of a single reader.
+type OpenFunc func() (io.ReadCloser, error)
+
+// File returns an OpenFunc that opens the named file with [os.Open].
+func File(path string) OpenFunc {
+	return func() (io.ReadCloser, error) {
+		return os.Open(path)
+	}
+}
+
+// Reopen builds a non-volatile sequence from a reader based source, such as
+// [Lines] or [Words], by calling open at the start of each iteration and
+// passing the reader to the source. The reader is closed as soon as the
+// iteration ends, including when it stops early or fails. An error from
+// opening or closing the reader is returned from the iteration.
+func Reopen[T any](open OpenFunc, source func(io.Reader) sequence.Sequence[T]) sequence.Sequence[T] {
+	return sequence.Generate(func(f func(T) error) (err error) {
+		rc, err := open()
+		if err != nil {
+			return err
+		}
+		defer func() { err = tools.Or(err, rc.Close()) }()
+		return source(rc).Each(f)
+	})
+}
+
+// LinesFile returns a sequence of the lines in the named file, as produced by
+// [Lines]. Unlike Lines, the sequence isn't volatile, since the file is opened
+// again for each iteration. Like Lines, files compressed with gzip or bzip2
+// are decompressed as they're read.
+func LinesFile(path string) sequence.Sequence[string] {
+	return Reopen(File(path), Lines)
+}
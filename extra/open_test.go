@@ -0,0 +1,75 @@
+package extra
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cookieo9/sequence"
+)
+
+type trackedReader struct {
+	io.Reader
+	closed *int
+}
+
+func (t trackedReader) Close() error {
+	*t.closed++
+	return nil
+}
+
+func TestLinesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, []byte(text), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	seq := LinesFile(path)
+	if seq.IsVolatile() {
+		t.Error("LinesFile returned a volatile sequence")
+	}
+	want := Lines(strings.NewReader(text)).ToSlice().Value()
+	for i := 0; i < 2; i++ {
+		got, err := seq.ToSlice().Pair()
+		if err != nil {
+			t.Errorf("unexpected error on iteration %d: %v", i, err)
+		}
+		if strings.Join(got, "\n") != strings.Join(want, "\n") {
+			t.Errorf("unexpected lines on iteration %d; got %q, want %q", i, got, want)
+		}
+	}
+
+	missing := LinesFile(filepath.Join(t.TempDir(), "missing.txt"))
+	if err := sequence.Each(missing)(func(string) error { return nil }); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected fs.ErrNotExist, got %v", err)
+	}
+}
+
+func TestReopen(t *testing.T) {
+	opened, closed := 0, 0
+	open := func() (io.ReadCloser, error) {
+		opened++
+		return trackedReader{strings.NewReader(text), &closed}, nil
+	}
+	seq := Reopen(open, Words)
+
+	first := sequence.First(seq).Value()
+	if first != "hello," {
+		t.Errorf("unexpected first word; got %q, want %q", first, "hello,")
+	}
+	if opened != 1 || closed != 1 {
+		t.Errorf("reader not closed after stopping early; opened %d, closed %d", opened, closed)
+	}
+
+	n := sequence.Count(seq).Value()
+	if n != 12 {
+		t.Errorf("unexpected word count; got %d, want 12", n)
+	}
+	if opened != 2 || closed != 2 {
+		t.Errorf("reader not closed after iterating; opened %d, closed %d", opened, closed)
+	}
+}
@@ -0,0 +1,417 @@
+package extra
+
+import (
+	"encoding"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cookieo9/sequence"
+)
+
+// A ParseError reports where a line read by [ParseLines] failed to match the
+// format, with the 1-based line and column (in runes) of the problem, what
+// the format expected there, and what was found instead. Err holds the
+// underlying cause, if there is one: for a number that fails to convert it's
+// the sentinel from the [*strconv.NumError], such as [strconv.ErrRange], since
+// the ParseError already holds the text, and for an [encoding.TextUnmarshaler]
+// it's the error returned by UnmarshalText.
+type ParseError struct {
+	Line, Column int
+	Expected     string
+	Actual       string
+	Err          error
+}
+
+// Error returns a description of the problem and its position.
+func (e *ParseError) Error() string {
+	msg := fmt.Sprintf("line %d, column %d: expected %s, found %s", e.Line, e.Column, e.Expected, e.Actual)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Unwrap returns the underlying error, if any.
+func (e *ParseError) Unwrap() error { return e.Err }
+
+// scanPart is a piece of a parsed format: literal text to match, followed by a
+// verb, which is zero for the text after the last verb.
+type scanPart struct {
+	literal string
+	verb    rune
+}
+
+var scanVerbNames = map[rune]string{
+	'd': "integer",
+	'f': "number",
+	't': "boolean",
+	's': "word",
+	'c': "character",
+}
+
+// parseScanFormat splits a format into its parts, checking that it only uses
+// the supported verbs.
+func parseScanFormat(format string) ([]scanPart, error) {
+	var parts []scanPart
+	var lit strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			lit.WriteByte(format[i])
+			continue
+		}
+		i++
+		if i == len(format) {
+			return nil, fmt.Errorf("format %q ends with %%", format)
+		}
+		verb := rune(format[i])
+		switch verb {
+		case '%':
+			lit.WriteByte('%')
+			continue
+		case 'd', 'f', 't', 's', 'c', 'v':
+		default:
+			return nil, fmt.Errorf("format %q has unsupported verb %%%c", format, verb)
+		}
+		parts = append(parts, scanPart{literal: lit.String(), verb: verb})
+		lit.Reset()
+	}
+	return append(parts, scanPart{literal: lit.String()}), nil
+}
+
+// scanTargets returns the positions of the values of typ that are filled by
+// the verbs of a format, along with the format given by struct tags, if
+// useTags is set.
+func scanTargets(typ reflect.Type, useTags bool) ([][]int, string, error) {
+	switch typ.Kind() {
+	case reflect.Struct:
+		var targets [][]int
+		var format strings.Builder
+		for i := 0; i < typ.NumField(); i++ {
+			sf := typ.Field(i)
+			tag, tagged := sf.Tag.Lookup("scan")
+			if !sf.IsExported() || tag == "-" || (useTags && !tagged) {
+				continue
+			}
+			targets = append(targets, sf.Index)
+			format.WriteString(tag)
+		}
+		if useTags && len(targets) == 0 {
+			return nil, "", fmt.Errorf("type %v has no scan tags", typ)
+		}
+		return targets, format.String(), nil
+	case reflect.Array:
+		targets := make([][]int, typ.Len())
+		for i := range targets {
+			targets[i] = []int{i}
+		}
+		return targets, "", nil
+	default:
+		if useTags {
+			return nil, "", fmt.Errorf("type %v has no scan tags", typ)
+		}
+		return [][]int{nil}, "", nil
+	}
+}
+
+var scanUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// scanVerb checks that a verb can fill a value of the given type, returning
+// the verb to use in place of %v.
+func scanVerb(verb rune, typ reflect.Type) (rune, bool) {
+	text := reflect.PointerTo(typ).Implements(scanUnmarshalerType)
+	switch k := typ.Kind(); {
+	case k >= reflect.Int && k <= reflect.Uintptr:
+		if verb == 'v' {
+			verb = 'd'
+		}
+		return verb, verb == 'd' || verb == 'c'
+	case k == reflect.Float32 || k == reflect.Float64:
+		if verb == 'v' {
+			verb = 'f'
+		}
+		return verb, verb == 'f'
+	case k == reflect.Bool:
+		if verb == 'v' {
+			verb = 't'
+		}
+		return verb, verb == 't'
+	case k == reflect.String || text:
+		if verb == 'v' {
+			verb = 's'
+		}
+		return verb, verb == 's' || (verb == 'c' && !text)
+	}
+	return verb, false
+}
+
+// lineScanner matches a single line against the parts of a format.
+type lineScanner struct {
+	text string
+	pos  int
+	line int
+}
+
+func (ls *lineScanner) fail(expected string, err error) error {
+	actual := "end of line"
+	if rest := ls.text[ls.pos:]; rest != "" {
+		end := strings.IndexFunc(rest, unicode.IsSpace)
+		if end < 0 {
+			end = len(rest)
+		} else if end == 0 {
+			_, end = utf8.DecodeRuneInString(rest)
+		}
+		word := rest[:end]
+		if r := []rune(word); len(r) > 20 {
+			word = string(r[:17]) + "..."
+		}
+		actual = strconv.Quote(word)
+	}
+	return ls.failAt(ls.pos, expected, actual, err)
+}
+
+func (ls *lineScanner) failAt(pos int, expected, actual string, err error) error {
+	return &ParseError{
+		Line:     ls.line,
+		Column:   utf8.RuneCountInString(ls.text[:pos]) + 1,
+		Expected: expected,
+		Actual:   actual,
+		Err:      err,
+	}
+}
+
+func (ls *lineScanner) skipSpace() {
+	for ls.pos < len(ls.text) && (ls.text[ls.pos] == ' ' || ls.text[ls.pos] == '\t') {
+		ls.pos++
+	}
+}
+
+// literal matches text from the format, where each run of spaces matches any
+// amount of space in the line, including none. Other text is matched a word at
+// a time, so a mismatch reports the whole word expected.
+func (ls *lineScanner) literal(lit string) error {
+	for lit != "" {
+		if lit[0] == ' ' {
+			ls.skipSpace()
+			lit = lit[1:]
+			continue
+		}
+		word := lit
+		if i := strings.IndexByte(lit, ' '); i >= 0 {
+			word = lit[:i]
+		}
+		if !strings.HasPrefix(ls.text[ls.pos:], word) {
+			return ls.fail(strconv.Quote(word), nil)
+		}
+		ls.pos += len(word)
+		lit = lit[len(word):]
+	}
+	return nil
+}
+
+// span advances over the runes accepted by ok, returning them.
+func (ls *lineScanner) span(ok func(i int, r rune) bool) string {
+	start := ls.pos
+	for i, r := range ls.text[start:] {
+		if !ok(i, r) {
+			break
+		}
+		ls.pos = start + i + utf8.RuneLen(r)
+	}
+	return ls.text[start:ls.pos]
+}
+
+func isSign(r rune) bool  { return r == '-' || r == '+' }
+func isDigit(r rune) bool { return r >= '0' && r <= '9' }
+
+// token reads the text for a verb, where stop is a rune that ends a word
+// early, or -1 if there isn't one.
+func (ls *lineScanner) token(verb rune, stop rune) string {
+	switch verb {
+	case 'd':
+		return ls.span(func(i int, r rune) bool { return isDigit(r) || (i == 0 && isSign(r)) })
+	case 'f':
+		prev := rune(-1)
+		return ls.span(func(i int, r rune) bool {
+			ok := isDigit(r) || r == '.' || r == 'e' || r == 'E' ||
+				(isSign(r) && (i == 0 || prev == 'e' || prev == 'E'))
+			prev = r
+			return ok
+		})
+	case 't':
+		return ls.span(func(_ int, r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) })
+	case 'c':
+		return ls.span(func(i int, _ rune) bool { return i == 0 })
+	default:
+		return ls.span(func(_ int, r rune) bool { return !unicode.IsSpace(r) && r != stop })
+	}
+}
+
+// setScanValue stores the text of a token in v, according to the verb.
+func setScanValue(v reflect.Value, verb rune, tok string) error {
+	if verb == 's' && reflect.PointerTo(v.Type()).Implements(scanUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(tok))
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if verb == 'c' {
+			r, _ := utf8.DecodeRuneInString(tok)
+			tok = strconv.Itoa(int(r))
+		}
+		n, err := strconv.ParseInt(tok, 10, v.Type().Bits())
+		v.SetInt(n)
+		return err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if verb == 'c' {
+			r, _ := utf8.DecodeRuneInString(tok)
+			tok = strconv.Itoa(int(r))
+		}
+		n, err := strconv.ParseUint(strings.TrimPrefix(tok, "+"), 10, v.Type().Bits())
+		v.SetUint(n)
+		return err
+	case reflect.Float32, reflect.Float64:
+		x, err := strconv.ParseFloat(tok, v.Type().Bits())
+		v.SetFloat(x)
+		return err
+	case reflect.Bool:
+		b, err := strconv.ParseBool(tok)
+		v.SetBool(b)
+		return err
+	default:
+		v.SetString(tok)
+		return nil
+	}
+}
+
+// ParseLines returns a sequence of values of type T decoded from the lines
+// read from r, each of which must match the given format. It's a more
+// convenient and more helpful alternative to calling [fmt.Sscanf] on each
+// line. Blank lines are skipped.
+//
+// The format is made of literal text and verbs, each of which reads a value:
+//
+//	%d  a decimal integer, with an optional sign
+//	%f  a floating point number
+//	%t  a boolean, as accepted by [strconv.ParseBool]
+//	%s  a word, ending at white space or at the literal text after the verb
+//	%c  a single character
+//	%v  the verb matching the type of the value
+//	%%  a literal percent sign
+//
+// Spaces in the format match any amount of space or tab characters,
+// including none, and other text must match exactly. Like [fmt.Sscanf], all
+// verbs but %c skip leading space. The whole line must be
+// matched, apart from trailing space.
+//
+// If T is a struct, the verbs fill its exported fields in order, skipping
+// fields tagged `scan:"-"`. If T is an array, the verbs fill its elements in
+// order, and otherwise a single verb fills the value itself. The number of
+// verbs must match the number of values, and each verb must suit the type of
+// its value: integers accept %d and %c, floats %f, bools %t, and strings or
+// types implementing [encoding.TextUnmarshaler] accept %s (strings also
+// accept %c).
+//
+// If the format is empty, T must be a struct where the fields to fill have a
+// `scan:"..."` tag holding a piece of the format with a single verb, such as
+// `scan:"move %d"`. The pieces are joined in field order to form the format.
+//
+// A line that doesn't match fails with a [*ParseError]. The sequence is
+// Volatile, since the reader can only be consumed once.
+func ParseLines[T any](r io.Reader, format string) sequence.Sequence[T] {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	targets, tagFormat, err := scanTargets(typ, format == "")
+	if err != nil {
+		return sequence.Error[T](fmt.Errorf("called ParseLines with invalid type: %w", err))
+	}
+	if format == "" {
+		format = tagFormat
+	}
+	parts, err := parseScanFormat(format)
+	if err != nil {
+		return sequence.Error[T](fmt.Errorf("called ParseLines with invalid format: %w", err))
+	}
+	if len(parts)-1 != len(targets) {
+		return sequence.Error[T](fmt.Errorf("called ParseLines with format %q that has %d verbs for %d values of %v", format, len(parts)-1, len(targets), typ))
+	}
+	for i, target := range targets {
+		ft := typ
+		switch {
+		case typ.Kind() == reflect.Array:
+			ft = typ.Elem()
+		case target != nil:
+			ft = typ.FieldByIndex(target).Type
+		}
+		verb, ok := scanVerb(parts[i].verb, ft)
+		if !ok {
+			return sequence.Error[T](fmt.Errorf("called ParseLines with verb %%%c for value %d of type %v", parts[i].verb, i, ft))
+		}
+		parts[i].verb = verb
+	}
+
+	lines := Lines(r)
+	return sequence.GenerateVolatile(func(f func(T) error) error {
+		n := 0
+		return lines.Each(func(line string) error {
+			n++
+			if strings.TrimSpace(line) == "" {
+				return nil
+			}
+			var t T
+			if err := scanLine(&lineScanner{text: line, line: n}, parts, targets, reflect.ValueOf(&t).Elem()); err != nil {
+				return err
+			}
+			return f(t)
+		})
+	})
+}
+
+// scanLine matches a line against the parts of a format, storing the values
+// read in the targets of v.
+func scanLine(ls *lineScanner, parts []scanPart, targets [][]int, v reflect.Value) error {
+	for i, part := range parts {
+		if err := ls.literal(part.literal); err != nil {
+			return err
+		}
+		if part.verb == 0 {
+			break
+		}
+
+		stop := rune(-1)
+		if next := parts[i+1].literal; next != "" && next[0] != ' ' {
+			stop, _ = utf8.DecodeRuneInString(next)
+		}
+		if part.verb != 'c' {
+			ls.skipSpace()
+		}
+		start := ls.pos
+		tok := ls.token(part.verb, stop)
+		if tok == "" {
+			return ls.fail(scanVerbNames[part.verb], nil)
+		}
+
+		target := v
+		switch {
+		case v.Kind() == reflect.Array:
+			target = v.Index(targets[i][0])
+		case targets[i] != nil:
+			target = v.FieldByIndex(targets[i])
+		}
+		if err := setScanValue(target, part.verb, tok); err != nil {
+			// The NumError would repeat the token, so keep only its cause.
+			if ne, ok := err.(*strconv.NumError); ok {
+				err = ne.Err
+			}
+			return ls.failAt(start, scanVerbNames[part.verb], strconv.Quote(tok), err)
+		}
+	}
+
+	ls.skipSpace()
+	if ls.pos < len(ls.text) {
+		return ls.fail("end of line", nil)
+	}
+	return nil
+}
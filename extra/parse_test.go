@@ -0,0 +1,135 @@
+package extra
+
+import (
+	"errors"
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cookieo9/sequence"
+	"github.com/google/go-cmp/cmp"
+)
+
+type move struct {
+	Count int `scan:"move %d"`
+	From  int `scan:" from %d"`
+	To    int `scan:" to %d"`
+	Note  string
+}
+
+type reading struct {
+	Name  string
+	Temp  float64
+	OK    bool
+	Grade rune
+	skip  int
+}
+
+type host struct {
+	Addr netip.Addr
+	Port uint16
+}
+
+func TestParseLines(t *testing.T) {
+	t.Run("Format", func(t *testing.T) {
+		input := "kitchen: 21.5 true A\n\n  attic:-3e1   false  B  \n"
+		got, err := ParseLines[reading](strings.NewReader(input), "%s: %f %t %c").ToSlice().Pair()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		want := []reading{{"kitchen", 21.5, true, 'A', 0}, {"attic", -30, false, 'B', 0}}
+		if diff := cmp.Diff(got, want, cmp.AllowUnexported(reading{})); diff != "" {
+			t.Errorf("unexpected values (-got, +want):\n%s", diff)
+		}
+	})
+
+	t.Run("Tags", func(t *testing.T) {
+		input := "move 3 from 1 to 2\nmove 12 from 10 to 7"
+		got, err := ParseLines[move](strings.NewReader(input), "").ToSlice().Pair()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(got, []move{{3, 1, 2, ""}, {12, 10, 7, ""}}); diff != "" {
+			t.Errorf("unexpected values (-got, +want):\n%s", diff)
+		}
+	})
+
+	t.Run("Array", func(t *testing.T) {
+		got, err := ParseLines[[4]int](strings.NewReader("1-2,+3-4\n"), "%d-%d,%d-%d").ToSlice().Pair()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(got, [][4]int{{1, 2, 3, 4}}); diff != "" {
+			t.Errorf("unexpected values (-got, +want):\n%s", diff)
+		}
+	})
+
+	t.Run("Scalar", func(t *testing.T) {
+		got, err := ParseLines[int](strings.NewReader("#1\n#22\n"), "#%v").ToSlice().Pair()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(got, []int{1, 22}); diff != "" {
+			t.Errorf("unexpected values (-got, +want):\n%s", diff)
+		}
+	})
+
+	t.Run("TextUnmarshaler", func(t *testing.T) {
+		got, err := ParseLines[host](strings.NewReader("10.0.0.1:80 100%\n"), "%s:%d 100%%").ToSlice().Pair()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(got, []host{{netip.MustParseAddr("10.0.0.1"), 80}}, cmp.Comparer(func(a, b netip.Addr) bool { return a == b })); diff != "" {
+			t.Errorf("unexpected values (-got, +want):\n%s", diff)
+		}
+	})
+}
+
+func TestParseLinesErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		format string
+		want   ParseError
+	}{
+		{"Literal", "move 1 from 2 to 3\nmove 2 form 3 to 4", "", ParseError{Line: 2, Column: 8, Expected: `"from"`, Actual: `"form"`}},
+		{"Number", "move x from 1 to 2", "", ParseError{Line: 1, Column: 6, Expected: "integer", Actual: `"x"`}},
+		{"Range", "move 99999999999999999999 from 1 to 2", "", ParseError{Line: 1, Column: 6, Expected: "integer", Actual: `"99999999999999999999"`, Err: strconv.ErrRange}},
+		{"Trailing", "move 1 from 2 to 3 now", "", ParseError{Line: 1, Column: 20, Expected: "end of line", Actual: `"now"`}},
+		{"Short", "move 1 from 2", "", ParseError{Line: 1, Column: 14, Expected: `"to"`, Actual: "end of line"}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := ParseLines[move](strings.NewReader(tc.input), tc.format).ToSlice().Error()
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("expected *ParseError, got %v", err)
+			}
+			if diff := cmp.Diff(*pe, tc.want, cmp.Comparer(func(a, b error) bool { return a == b })); diff != "" {
+				t.Errorf("unexpected error (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseLinesInvalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		seq  sequence.Sequence[reading]
+	}{
+		{"TooFewVerbs", ParseLines[reading](strings.NewReader(""), "%s %f")},
+		{"WrongVerb", ParseLines[reading](strings.NewReader(""), "%d %f %t %c")},
+		{"BadVerb", ParseLines[reading](strings.NewReader(""), "%s %x %t %c")},
+		{"NoTags", ParseLines[reading](strings.NewReader(""), "")},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.seq.ToSlice().Error(); err == nil {
+				t.Error("expected an error for an invalid format or type")
+			}
+		})
+	}
+}
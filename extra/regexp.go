@@ -0,0 +1,48 @@
+package extra
+
+import (
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/cookieo9/sequence"
+)
+
+// Matches returns a sequence of every match of re in each string of the input
+// sequence, in order, as found by [regexp.Regexp.FindAllString].
+func Matches(re *regexp.Regexp, s sequence.Sequence[string]) sequence.Sequence[string] {
+	return sequence.Flatten(sequence.Map(s, func(text string) []string {
+		return re.FindAllString(text, -1)
+	}))
+}
+
+// Submatches returns a sequence of every match of re in each string of the
+// input sequence, as found by [regexp.Regexp.FindAllStringSubmatch]. Each
+// match is a slice holding the text of the whole match followed by that of
+// each capture group, where groups that didn't participate are empty.
+func Submatches(re *regexp.Regexp, s sequence.Sequence[string]) sequence.Sequence[[]string] {
+	return sequence.Flatten(sequence.Map(s, func(text string) [][]string {
+		return re.FindAllStringSubmatch(text, -1)
+	}))
+}
+
+var intPattern = regexp.MustCompile(`[-+]?\d+`)
+
+// ParseInts returns a sequence of the decimal integers found in line, which
+// may be signed, ignoring any text between them. A number too large for an
+// int fails with a [*strconv.NumError].
+//
+// A '-' directly before a digit is always taken as a sign, so "3-4" produces
+// 3 and -4.
+func ParseInts(line string) sequence.Sequence[int] {
+	return sequence.MapErr(Matches(intPattern, sequence.Single(line)), strconv.Atoi)
+}
+
+// FindAll returns a sequence of the matches of re in the text read from r.
+// The input is read one line at a time, as with [Lines], so only the current
+// line is held in memory, but matches can't span more than one line.
+//
+// The sequence is Volatile, since the reader can only be consumed once.
+func FindAll(re *regexp.Regexp, r io.Reader) sequence.Sequence[string] {
+	return Matches(re, Lines(r))
+}
@@ -0,0 +1,71 @@
+package extra
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cookieo9/sequence"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMatches(t *testing.T) {
+	re := regexp.MustCompile(`[a-z]+=\d`)
+	input := sequence.FromSlice([]string{"a=1 b=2", "none", "c=3"})
+	got := Matches(re, input).ToSlice().Value()
+	if diff := cmp.Diff(got, []string{"a=1", "b=2", "c=3"}); diff != "" {
+		t.Errorf("unexpected matches (-got, +want):\n%s", diff)
+	}
+}
+
+func TestSubmatches(t *testing.T) {
+	re := regexp.MustCompile(`(\w+)=(\d)(!)?`)
+	input := sequence.FromSlice([]string{"a=1 b=2!", "c=3"})
+	got := Submatches(re, input).ToSlice().Value()
+	want := [][]string{{"a=1", "a", "1", ""}, {"b=2!", "b", "2", "!"}, {"c=3", "c", "3", ""}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected submatches (-got, +want):\n%s", diff)
+	}
+}
+
+func TestParseInts(t *testing.T) {
+	testCases := []struct {
+		line string
+		want []int
+	}{
+		{"", nil},
+		{"no numbers", nil},
+		{"Sensor at x=2, y=-18: beacon at +7", []int{2, -18, 7}},
+		{"3-4 007", []int{3, -4, 7}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.line, func(t *testing.T) {
+			got, err := ParseInts(tc.line).ToSlice().Pair()
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected ints (-got, +want):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("Overflow", func(t *testing.T) {
+		err := ParseInts("1 99999999999999999999").ToSlice().Error()
+		if !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("expected strconv.ErrRange, got %v", err)
+		}
+	})
+}
+
+func TestFindAll(t *testing.T) {
+	re := regexp.MustCompile(`\w+@\w+`)
+	input := "mail bob@home and\nann@work, or\nnobody"
+	got := FindAll(re, strings.NewReader(input)).ToSlice().Value()
+	if diff := cmp.Diff(got, []string{"bob@home", "ann@work"}); diff != "" {
+		t.Errorf("unexpected matches (-got, +want):\n%s", diff)
+	}
+}
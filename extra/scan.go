@@ -0,0 +1,129 @@
+package extra
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cookieo9/sequence"
+)
+
+// ScanOptions controls the buffering of a [bufio.Scanner] used by [Scan]. The
+// zero value (or a nil pointer) uses the defaults of bufio.Scanner.
+type ScanOptions struct {
+	// MaxTokenSize is the largest token that can be produced. Longer tokens
+	// fail with [bufio.ErrTooLong]. Values <= 0 use
+	// [bufio.MaxScanTokenSize] (64KiB).
+	MaxTokenSize int
+
+	// InitialBufferSize is the size of the buffer allocated up front, which
+	// grows as needed up to MaxTokenSize. Values <= 0 use a default of 4KiB.
+	InitialBufferSize int
+}
+
+// Scan returns a sequence of the tokens produced by a [bufio.Scanner] reading
+// from r and using the given split function, such as [bufio.ScanWords]. The
+// options may be nil to use the defaults.
+//
+// Like [Lines], the sequence is Volatile, since the reader can only be
+// consumed once.
+func Scan(r io.Reader, split bufio.SplitFunc, opts *ScanOptions) sequence.Sequence[string] {
+	scn := bufio.NewScanner(r)
+	scn.Split(split)
+	if opts != nil {
+		maxSize := opts.MaxTokenSize
+		if maxSize <= 0 {
+			maxSize = bufio.MaxScanTokenSize
+		}
+		initial := opts.InitialBufferSize
+		if initial <= 0 {
+			initial = 4096
+		}
+		scn.Buffer(make([]byte, 0, min(initial, maxSize)), maxSize)
+	}
+	return sequence.GenerateVolatile(func(f func(string) error) error {
+		for scn.Scan() {
+			if err := f(scn.Text()); err != nil {
+				return err
+			}
+		}
+		return scn.Err()
+	})
+}
+
+// Words returns a sequence of the space separated words read from r, as split
+// by [bufio.ScanWords].
+func Words(r io.Reader) sequence.Sequence[string] {
+	return Scan(r, bufio.ScanWords, nil)
+}
+
+// Runes returns a sequence of the UTF-8 encoded runes read from r. Invalid
+// encodings produce [utf8.RuneError].
+func Runes(r io.Reader) sequence.Sequence[rune] {
+	return sequence.Map(Scan(r, bufio.ScanRunes, nil), func(s string) rune {
+		c, _ := utf8.DecodeRuneInString(s)
+		return c
+	})
+}
+
+// Bytes returns a sequence of the bytes read from r.
+func Bytes(r io.Reader) sequence.Sequence[byte] {
+	return sequence.Map(Scan(r, bufio.ScanBytes, nil), func(s string) byte {
+		return s[0]
+	})
+}
+
+// SplitOn returns a sequence of the pieces of the text read from r, separated
+// by delim. Like [strings.Split], empty pieces between consecutive delimiters
+// are kept, but no empty piece is produced after a trailing delimiter. An
+// empty delimiter splits the input into UTF-8 runes.
+func SplitOn(r io.Reader, delim string) sequence.Sequence[string] {
+	return Scan(r, splitOn([]byte(delim)), nil)
+}
+
+func splitOn(delim []byte) bufio.SplitFunc {
+	if len(delim) == 0 {
+		return bufio.ScanRunes
+	}
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := bytes.Index(data, delim); i >= 0 {
+			return i + len(delim), data[:i], nil
+		}
+		if atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	}
+}
+
+// Paragraphs returns a sequence of the paragraphs of text read from r, where
+// paragraphs are groups of lines separated by one or more blank lines (lines
+// containing only white space). Each paragraph is produced as a slice of its
+// lines.
+//
+// This is handy for inputs made of several blocks, such as those of Advent of
+// Code puzzles.
+func Paragraphs(r io.Reader) sequence.Sequence[[]string] {
+	lines := Lines(r)
+	return sequence.GenerateVolatile(func(f func([]string) error) error {
+		var para []string
+		err := lines.Each(func(line string) error {
+			if strings.TrimSpace(line) != "" {
+				para = append(para, line)
+				return nil
+			}
+			if len(para) == 0 {
+				return nil
+			}
+			p := para
+			para = nil
+			return f(p)
+		})
+		if err != nil || len(para) == 0 {
+			return err
+		}
+		return f(para)
+	})
+}
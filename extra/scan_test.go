@@ -0,0 +1,87 @@
+package extra
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cookieo9/sequence"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestScan(t *testing.T) {
+	long := strings.Repeat("x", 100_000)
+
+	t.Run("TooLong", func(t *testing.T) {
+		seq := Lines(strings.NewReader("short\n" + long + "\n"))
+		err := sequence.Each(seq)(func(string) error { return nil })
+		if !errors.Is(err, bufio.ErrTooLong) {
+			t.Errorf("expected bufio.ErrTooLong, got %v", err)
+		}
+	})
+
+	t.Run("MaxTokenSize", func(t *testing.T) {
+		opts := &ScanOptions{MaxTokenSize: 1 << 20}
+		seq := Scan(strings.NewReader("short\n"+long+"\n"), bufio.ScanLines, opts)
+		got, err := sequence.ToSlice(seq).Pair()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(got, []string{"short", long}); diff != "" {
+			t.Errorf("unexpected lines (-got, +want):\n%s", diff)
+		}
+	})
+}
+
+func TestSplitters(t *testing.T) {
+	testCases := []struct {
+		name string
+		seq  sequence.Sequence[string]
+		want []string
+	}{
+		{"Words", Words(strings.NewReader(" hello,  world\n\tagain ")), []string{"hello,", "world", "again"}},
+		{"SplitOn", SplitOn(strings.NewReader("1,2,,3,"), ","), []string{"1", "2", "", "3"}},
+		{"SplitOnMulti", SplitOn(strings.NewReader("a::b:c::d"), "::"), []string{"a", "b:c", "d"}},
+		{"SplitOnEmpty", SplitOn(strings.NewReader("héllo"), ""), []string{"h", "é", "l", "l", "o"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.seq.ToSlice().Pair()
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected tokens (-got, +want):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("Runes", func(t *testing.T) {
+		got := Runes(strings.NewReader("héllo")).ToSlice().Value()
+		if diff := cmp.Diff(got, []rune("héllo")); diff != "" {
+			t.Errorf("unexpected runes (-got, +want):\n%s", diff)
+		}
+	})
+
+	t.Run("Bytes", func(t *testing.T) {
+		got := Bytes(strings.NewReader("héllo")).ToSlice().Value()
+		if diff := cmp.Diff(got, []byte("héllo")); diff != "" {
+			t.Errorf("unexpected bytes (-got, +want):\n%s", diff)
+		}
+	})
+}
+
+func TestParagraphs(t *testing.T) {
+	input := "\n1000\n2000\n\n4000\n  \n\n5000\n6000\r\n"
+	got, err := Paragraphs(strings.NewReader(input)).ToSlice().Pair()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	want := [][]string{{"1000", "2000"}, {"4000"}, {"5000", "6000"}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected paragraphs (-got, +want):\n%s", diff)
+	}
+}
@@ -0,0 +1,83 @@
+package extra
+
+import (
+	"io/fs"
+
+	"github.com/cookieo9/sequence"
+	"github.com/cookieo9/sequence/tools"
+)
+
+// A WalkEntry is a file or directory visited by [WalkDir], along with its
+// path, which is rooted at the starting directory as in [fs.WalkDir].
+type WalkEntry struct {
+	Path string
+	fs.DirEntry
+}
+
+// WalkDir returns a sequence of the files and directories in the tree rooted
+// at root in fsys, in the lexical order used by [fs.WalkDir], starting with
+// root itself. The tree is walked lazily as the sequence is iterated, and can
+// be walked again by iterating again. Errors reading a directory stop the
+// iteration and are returned.
+func WalkDir(fsys fs.FS, root string) sequence.Sequence[WalkEntry] {
+	return WalkDirFunc(fsys, root, nil)
+}
+
+// WalkDirFunc is like [WalkDir], but calls filter on each entry before it's
+// produced, which allows parts of the tree to be pruned. If filter returns
+// [fs.SkipDir] for a directory the entry is dropped and the directory isn't
+// entered, and for a file the file and its remaining siblings are dropped. If
+// it returns [fs.SkipAll] the walk ends. Any other error stops the iteration
+// and is returned. A nil filter keeps every entry.
+//
+// To drop single entries without skipping others, use [sequence.Filter] on
+// the result instead.
+func WalkDirFunc(fsys fs.FS, root string, filter func(WalkEntry) error) sequence.Sequence[WalkEntry] {
+	return sequence.Generate(func(f func(WalkEntry) error) error {
+		var stop error
+		err := fs.WalkDir(fsys, root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			entry := WalkEntry{Path: path, DirEntry: d}
+			if filter != nil {
+				if err := filter(entry); err != nil {
+					return err
+				}
+			}
+			if err := f(entry); err != nil {
+				stop = err
+				return fs.SkipAll
+			}
+			return nil
+		})
+		return tools.Or(stop, err)
+	})
+}
+
+// Glob returns a sequence of the names in fsys matching pattern, with the
+// syntax of [fs.Glob]. The matches are found when the sequence is iterated,
+// and a malformed pattern fails with [path.ErrBadPattern].
+func Glob(fsys fs.FS, pattern string) sequence.Sequence[string] {
+	return sequence.Generate(func(f func(string) error) error {
+		matches, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			return err
+		}
+		return sequence.FromSlice(matches).Each(f)
+	})
+}
+
+// ReadFiles returns a sequence pairing each path from paths with the contents
+// of that file in fsys. Files are read one at a time as the sequence is
+// iterated, and a file that can't be read stops the iteration with an
+// [*fs.PathError].
+func ReadFiles(fsys fs.FS, paths sequence.Sequence[string]) sequence.Sequence[sequence.Pair[string, []byte]] {
+	return sequence.MapErr(paths, func(path string) (sequence.Pair[string, []byte], error) {
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return sequence.Pair[string, []byte]{}, err
+		}
+		return sequence.MakePair(path, data), nil
+	})
+}
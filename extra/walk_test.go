@@ -0,0 +1,162 @@
+package extra
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/cookieo9/sequence"
+	"github.com/google/go-cmp/cmp"
+)
+
+var testFS = fstest.MapFS{
+	"a.txt":          {Data: []byte("a")},
+	"docs/b.md":      {Data: []byte("b")},
+	"docs/c.txt":     {Data: []byte("c")},
+	"skip/d.txt":     {Data: []byte("d")},
+	"skip/deep/e.md": {Data: []byte("e")},
+}
+
+func walkPaths(s sequence.Sequence[WalkEntry]) ([]string, error) {
+	return sequence.Map(s, func(e WalkEntry) string { return e.Path }).ToSlice().Pair()
+}
+
+func TestWalkDir(t *testing.T) {
+	want := []string{".", "a.txt", "docs", "docs/b.md", "docs/c.txt", "skip", "skip/d.txt", "skip/deep", "skip/deep/e.md"}
+	seq := WalkDir(testFS, ".")
+	for i := 0; i < 2; i++ {
+		got, err := walkPaths(seq)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("unexpected paths on iteration %d (-got, +want):\n%s", i, diff)
+		}
+	}
+
+	t.Run("Lazy", func(t *testing.T) {
+		got, err := walkPaths(WalkDir(testFS, "docs").Limit(2))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(got, []string{"docs", "docs/b.md"}); diff != "" {
+			t.Errorf("unexpected paths (-got, +want):\n%s", diff)
+		}
+	})
+
+	t.Run("DirFS", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := os.MkdirAll(filepath.Join(dir, "sub"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "sub", "f.txt"), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		got, err := walkPaths(WalkDir(os.DirFS(dir), "."))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(got, []string{".", "sub", "sub/f.txt"}); diff != "" {
+			t.Errorf("unexpected paths (-got, +want):\n%s", diff)
+		}
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		_, err := walkPaths(WalkDir(testFS, "missing"))
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("expected fs.ErrNotExist, got %v", err)
+		}
+	})
+}
+
+func TestWalkDirFunc(t *testing.T) {
+	errTest := errors.New("test error")
+	testCases := []struct {
+		name   string
+		filter func(WalkEntry) error
+		want   []string
+		err    error
+	}{
+		{
+			name: "SkipDir",
+			filter: func(e WalkEntry) error {
+				if e.IsDir() && e.Name() == "skip" {
+					return fs.SkipDir
+				}
+				return nil
+			},
+			want: []string{".", "a.txt", "docs", "docs/b.md", "docs/c.txt"},
+		},
+		{
+			name: "SkipAll",
+			filter: func(e WalkEntry) error {
+				if e.Path == "docs/c.txt" {
+					return fs.SkipAll
+				}
+				return nil
+			},
+			want: []string{".", "a.txt", "docs", "docs/b.md"},
+		},
+		{
+			name: "Error",
+			filter: func(e WalkEntry) error {
+				if path.Ext(e.Path) == ".md" {
+					return errTest
+				}
+				return nil
+			},
+			want: []string{".", "a.txt", "docs"},
+			err:  errTest,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			err := sequence.Each(WalkDirFunc(testFS, ".", tc.filter))(func(e WalkEntry) error {
+				got = append(got, e.Path)
+				return nil
+			})
+			if !errors.Is(err, tc.err) {
+				t.Errorf("unexpected error; got %v, want %v", err, tc.err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected paths (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGlob(t *testing.T) {
+	got, err := Glob(testFS, "*/*.txt").ToSlice().Pair()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(got, []string{"docs/c.txt", "skip/d.txt"}); diff != "" {
+		t.Errorf("unexpected matches (-got, +want):\n%s", diff)
+	}
+
+	if err := Glob(testFS, "[").ToSlice().Error(); !errors.Is(err, path.ErrBadPattern) {
+		t.Errorf("expected path.ErrBadPattern, got %v", err)
+	}
+}
+
+func TestReadFiles(t *testing.T) {
+	seq := ReadFiles(testFS, Glob(testFS, "docs/*"))
+	got := sequence.Map(seq, func(p sequence.Pair[string, []byte]) string {
+		return p.A() + "=" + string(p.B())
+	}).ToSlice().Value()
+	if diff := cmp.Diff(got, []string{"docs/b.md=b", "docs/c.txt=c"}); diff != "" {
+		t.Errorf("unexpected files (-got, +want):\n%s", diff)
+	}
+
+	err := ReadFiles(testFS, sequence.FromSlice([]string{"a.txt", "missing"})).ToSlice().Error()
+	var pe *fs.PathError
+	if !errors.As(err, &pe) || pe.Path != "missing" {
+		t.Errorf("expected *fs.PathError for missing, got %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+package extra
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/cookieo9/sequence"
+	"github.com/cookieo9/sequence/tools"
+)
+
+// WriteLines writes the items of the sequence to w, one per line, formatted
+// as if by [fmt.Fprintln]. Output is buffered, and errors from the sequence or
+// from writing stop the process and are returned.
+func WriteLines[T any](w io.Writer, s sequence.Sequence[T]) error {
+	return WriteFormatted(w, s, "%v\n")
+}
+
+// WriteFormatted writes the items of the sequence to w, each one formatted
+// with the given [fmt.Fprintf] format, which is expected to have a single verb
+// for the item. Nothing is added between items, so the format should include
+// any separator, such as a newline. Output is buffered, and errors from the
+// sequence or from writing stop the process and are returned.
+func WriteFormatted[T any](w io.Writer, s sequence.Sequence[T], format string) error {
+	bw := bufio.NewWriter(w)
+	err := sequence.Each(s.Sync())(func(t T) error {
+		_, err := fmt.Fprintf(bw, format, t)
+		return err
+	})
+	return tools.Or(err, bw.Flush())
+}
+
+// NewReader returns a reader producing the concatenated contents of the
+// items of the sequence, such as the chunks of a file or the pieces of a
+// document. The sequence is iterated in a separate goroutine as data is
+// read, and an error from it is returned from Read once the data before it
+// has been consumed.
+//
+// The reader must be closed if it isn't read to the end, to stop the
+// iteration and release the goroutine.
+func NewReader[T ~[]byte | ~string](s sequence.Sequence[T]) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		err := sequence.Each(sequence.Recover(s))(func(t T) error {
+			_, err := io.WriteString(pw, string(t))
+			return err
+		})
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
@@ -0,0 +1,111 @@
+package extra
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cookieo9/sequence"
+)
+
+type failWriter struct {
+	n int
+}
+
+var errWrite = errors.New("write failed")
+
+func (w *failWriter) Write(p []byte) (int, error) {
+	w.n++
+	return 0, errWrite
+}
+
+func TestWriteLines(t *testing.T) {
+	var sb strings.Builder
+	if err := WriteLines(&sb, sequence.FromSlice([]int{1, 2, 3})); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if want := "1\n2\n3\n"; sb.String() != want {
+		t.Errorf("unexpected output; got %q, want %q", sb.String(), want)
+	}
+}
+
+func TestWriteFormatted(t *testing.T) {
+	var sb strings.Builder
+	if err := WriteFormatted(&sb, sequence.FromSlice([]string{"a", "b"}), "[%s]"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if want := "[a][b]"; sb.String() != want {
+		t.Errorf("unexpected output; got %q, want %q", sb.String(), want)
+	}
+
+	t.Run("WriteError", func(t *testing.T) {
+		count := 0
+		seq := sequence.Generate(func(f func(string) error) error {
+			for {
+				count++
+				if err := f(strings.Repeat("x", 1024)); err != nil {
+					return err
+				}
+			}
+		})
+		w := &failWriter{}
+		if err := WriteFormatted(w, seq, "%s"); !errors.Is(err, errWrite) {
+			t.Errorf("expected %v, got %v", errWrite, err)
+		}
+		if w.n != 1 || count > 10 {
+			t.Errorf("iteration not stopped by write error; %d writes, %d items", w.n, count)
+		}
+	})
+}
+
+func TestNewReader(t *testing.T) {
+	t.Run("Strings", func(t *testing.T) {
+		r := NewReader(sequence.FromSlice([]string{"hello, ", "", "world"}))
+		data, err := io.ReadAll(r)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if string(data) != "hello, world" {
+			t.Errorf("unexpected data; got %q, want %q", data, "hello, world")
+		}
+	})
+
+	t.Run("Bytes", func(t *testing.T) {
+		r := NewReader(sequence.FromSlice([][]byte{[]byte("ab"), []byte("cd")}))
+		data, _ := io.ReadAll(r)
+		if string(data) != "abcd" {
+			t.Errorf("unexpected data; got %q, want %q", data, "abcd")
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		errTest := errors.New("test error")
+		seq := sequence.Concat(sequence.Single("partial"), sequence.Error[string](errTest))
+		data, err := io.ReadAll(NewReader(seq))
+		if string(data) != "partial" || !errors.Is(err, errTest) {
+			t.Errorf("unexpected result; got %q, %v, want %q, %v", data, err, "partial", errTest)
+		}
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		done := make(chan error)
+		seq := sequence.Generate(func(f func(string) error) error {
+			for {
+				if err := f("x"); err != nil {
+					done <- err
+					return err
+				}
+			}
+		})
+		r := NewReader(seq)
+		buf := make([]byte, 1)
+		if _, err := r.Read(buf); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		r.Close()
+		if err := <-done; !errors.Is(err, io.ErrClosedPipe) {
+			t.Errorf("expected io.ErrClosedPipe, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package sequence
+
+import "slices"
+
+// A stage is one stateless operation on the items of a sequence, as created
+// by [Map], [Filter] or [MapFilter]. Exactly one of the functions is set.
+// Stages that don't change the element type are fused together, so that a
+// chain of them runs in a single callback rather than one layer per stage.
+type stage[T any] struct {
+	mapper  func(T) T
+	pred    func(T) bool
+	convert func(T) (T, bool, error)
+}
+
+// A head is the stage at the start of a fused chain, which may change the
+// element type. Exactly one of the functions is set.
+type head[In, Out any] struct {
+	mapper  func(In) Out
+	convert func(In) (Out, bool, error)
+}
+
+// A pipeline records the stages fused into a sequence, and how to rebuild the
+// sequence with more stages appended.
+type pipeline[T any] struct {
+	stages []stage[T]
+	build  func([]stage[T]) Sequence[T]
+}
+
+// addStage returns a sequence applying the stage to the items of s. If s was
+// itself built from stages, the new stage is fused with them.
+func addStage[T any](s Sequence[T], st stage[T]) Sequence[T] {
+	if s.pipeline != nil {
+		return s.pipeline.build(append(slices.Clip(s.pipeline.stages), st))
+	}
+	return fuseStages(s, []stage[T]{st})
+}
+
+// fuseStages builds a sequence applying the stages to the items of s in a
+// single callback. The loop over the stages is written out here and in
+// fuseHead, rather than shared, since the extra call is measurable in
+// BenchmarkFusion.
+func fuseStages[T any](s Sequence[T], stages []stage[T]) Sequence[T] {
+	out := Derive(s, func(f func(T) error) error {
+		return s.Each(func(t T) error {
+			var (
+				ok  bool
+				err error
+			)
+			for i := range stages {
+				st := &stages[i]
+				if st.mapper != nil {
+					t = st.mapper(t)
+				} else if st.pred != nil {
+					if !st.pred(t) {
+						return nil
+					}
+				} else if t, ok, err = st.convert(t); err != nil || !ok {
+					return err
+				}
+			}
+			return f(t)
+		})
+	})
+	out.pipeline = &pipeline[T]{
+		stages: stages,
+		build:  func(stages []stage[T]) Sequence[T] { return fuseStages(s, stages) },
+	}
+	return out
+}
+
+// fuseHead is like fuseStages, but the head is applied to the items of s
+// first, converting them to the element type of the stages.
+func fuseHead[In, Out any](s Sequence[In], h head[In, Out], stages []stage[Out]) Sequence[Out] {
+	out := Derive(s, func(f func(Out) error) error {
+		return s.Each(func(in In) error {
+			var (
+				out Out
+				ok  bool
+				err error
+			)
+			if h.mapper != nil {
+				out = h.mapper(in)
+			} else if out, ok, err = h.convert(in); err != nil || !ok {
+				return err
+			}
+			for i := range stages {
+				st := &stages[i]
+				if st.mapper != nil {
+					out = st.mapper(out)
+				} else if st.pred != nil {
+					if !st.pred(out) {
+						return nil
+					}
+				} else if out, ok, err = st.convert(out); err != nil || !ok {
+					return err
+				}
+			}
+			return f(out)
+		})
+	})
+	out.pipeline = &pipeline[Out]{
+		stages: stages,
+		build:  func(stages []stage[Out]) Sequence[Out] { return fuseHead(s, h, stages) },
+	}
+	return out
+}
@@ -0,0 +1,163 @@
+package sequence
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cookieo9/sequence/tools"
+)
+
+func TestFusion(t *testing.T) {
+	var calls []string
+	trace := func(name string) {
+		calls = append(calls, name)
+	}
+
+	seq := New(1, 2, 3, 4, 5, 6)
+	evens := Filter(seq, func(i int) bool { trace("filter"); return i%2 == 0 })
+	tripled := Map(evens, func(i int) int { trace("map"); return i * 3 })
+	strs := Map(tripled, func(i int) string { trace("str"); return string(rune('a' + i)) })
+	short := Filter(strs, func(s string) bool { trace("short"); return s != "m" })
+
+	if n := len(tripled.pipeline.stages); n != 2 {
+		t.Errorf("unexpected number of fused stages; got %d, want 2", n)
+	}
+	if n := len(short.pipeline.stages); n != 1 {
+		t.Errorf("unexpected number of fused stages after type change; got %d, want 1", n)
+	}
+
+	compareSequences(t, short, New("g", "s"))
+	want := []string{
+		"filter",
+		"filter", "map", "str", "short",
+		"filter",
+		"filter", "map", "str", "short",
+		"filter",
+		"filter", "map", "str", "short",
+	}
+	// compareSequences iterates the sequence once, so the calls should be in
+	// the same order as an unfused pipeline.
+	if len(calls) != len(want) {
+		t.Fatalf("unexpected calls; got %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Fatalf("unexpected calls; got %v, want %v", calls, want)
+		}
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		bad := errors.New("bad")
+		failing := MapErr(tripled, func(i int) (int, error) {
+			if i == 12 {
+				return 0, bad
+			}
+			return i, nil
+		})
+		_ = checkErrorSequence(t, Filter(failing, func(int) bool { return true }), bad)
+	})
+
+	t.Run("Volatile", func(t *testing.T) {
+		v := Map(Map(Volatile(seq), func(i int) int { return i }), func(i int) int { return i })
+		if !v.IsVolatile() {
+			t.Error("fused stages lost volatility")
+		}
+		compareSequences(t, v, seq)
+		_ = checkErrorSequence(t, v, ErrRepeatedUse)
+	})
+}
+
+// unfusedMapFilter is MapFilter without fusion, where every stage adds a
+// layer of iteration.
+func unfusedMapFilter[In, Out any](s Sequence[In], convert func(In) (Out, bool, error)) Sequence[Out] {
+	return Derive(s, func(f func(Out) error) error {
+		return s.Each(func(i In) error {
+			out, ok, err := convert(i)
+			if err != nil {
+				return err
+			}
+			if ok {
+				return f(out)
+			}
+			return nil
+		})
+	})
+}
+
+// The fusion benchmarks run the same pipeline as a plain loop, as MapFilter
+// stages built without fusion, as fused MapFilter stages, and as fused
+// Map/Filter stages. Fusion mostly pays off for short sequences, where the
+// per-iteration setup of each unfused stage dominates.
+
+func fusionPipelineLoop(n int) int {
+	sum := 0
+	for i := 0; i < n; i++ {
+		if i%2 != 0 {
+			continue
+		}
+		x := i * 3
+		if x%5 == 0 {
+			continue
+		}
+		sum += x + 1
+	}
+	return sum
+}
+
+func fusionPipeline(seq Sequence[int], mf func(Sequence[int], func(int) (int, bool, error)) Sequence[int]) Sequence[int] {
+	even := mf(seq, func(i int) (int, bool, error) { return i, i%2 == 0, nil })
+	tripled := mf(even, func(i int) (int, bool, error) { return i * 3, true, nil })
+	notFive := mf(tripled, func(i int) (int, bool, error) { return i, i%5 != 0, nil })
+	return mf(notFive, func(i int) (int, bool, error) { return i + 1, true, nil })
+}
+
+func fusionPipelineAPI(seq Sequence[int]) Sequence[int] {
+	even := Filter(seq, func(i int) bool { return i%2 == 0 })
+	tripled := Map(even, func(i int) int { return i * 3 })
+	notFive := Filter(tripled, func(i int) bool { return i%5 != 0 })
+	return Map(notFive, func(i int) int { return i + 1 })
+}
+
+func sumEach(s Sequence[int]) int {
+	sum := 0
+	tools.Check(Each(s)(func(i int) error { sum += i; return nil }))
+	return sum
+}
+
+func BenchmarkFusion(b *testing.B) {
+	for _, n := range []int{10, 1_000_000} {
+		n := n
+		seq := NumberSequence(0, n, 1)
+		want := fusionPipelineLoop(n)
+
+		testCases := []struct {
+			name string
+			seq  Sequence[int]
+		}{
+			{"Unfused", fusionPipeline(seq, unfusedMapFilter[int, int])},
+			{"Fused", fusionPipeline(seq, MapFilter[int, int])},
+			{"MapFilterAPI", fusionPipelineAPI(seq)},
+		}
+
+		b.Run(fmt.Sprintf("N=%d/Loop", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = fusionPipelineLoop(n)
+			}
+		})
+
+		for _, tc := range testCases {
+			tc := tc
+			if got := sumEach(tc.seq); got != want {
+				b.Fatalf("%s pipeline sum mismatch; got %d, want %d", tc.name, got, want)
+			}
+			b.Run(fmt.Sprintf("N=%d/%s", n, tc.name), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					_ = sumEach(tc.seq)
+				}
+			})
+		}
+	}
+}
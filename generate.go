@@ -22,6 +22,25 @@ func GenerateVolatile[T any](f func(func(T) error) error) Sequence[T] {
 	return Volatile(Generate(f))
 }
 
+// GenerateIndexed creates an indexed sequence of n items, where the item at
+// each index is produced by the at function. The resulting sequence supports
+// random access through [Sequence.At], and knows its length without being
+// iterated. The at function should always return the same item for the same
+// index.
+func GenerateIndexed[T any](n int, at func(int) T) Sequence[T] {
+	n = max(n, 0)
+	s := Generate(func(f func(T) error) error {
+		for i := 0; i < n; i++ {
+			if err := f(at(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	s.indexed = &indexer[T]{size: n, at: at}
+	return s
+}
+
 // New creates a simple sequence using the provided items.
 func New[T any](items ...T) Sequence[T] {
 	return FromSlice(items)
@@ -42,9 +61,14 @@ func New[T any](items ...T) Sequence[T] {
 // The properties that are copied include:
 //   - volatile
 //   - async
+//   - unordered (items arrive in an order that depends on timing)
+//
+// The derived sequence is never indexed, since the sequence function may
+// produce a different number of items than the input.
 func Derive[Out, In any](input Sequence[In], f func(func(Out) error) error) Sequence[Out] {
 	out := Generate(f)
 	out.async = input.async
 	out.volatile = input.volatile
+	out.unordered = input.unordered
 	return out
 }
@@ -1,8 +1,17 @@
 package sequence
 
+import (
+	"fmt"
+	"math"
+)
+
 // Limit returns a sequence where only a given number of items can be accessed
-// from the input sequence before hitting the end of the sequence.
+// from the input sequence before hitting the end of the sequence. Limiting an
+// indexed sequence produces another indexed sequence.
 func Limit[T any](s Sequence[T], n int) Sequence[T] {
+	if size, ok := s.Len(); ok {
+		return GenerateIndexed(min(size, n), s.indexed.at)
+	}
 	return Derive[T](s, func(f func(T) error) error {
 		i := 0
 		return s.Each(func(t T) error {
@@ -58,3 +67,173 @@ func Until[T any](s Sequence[T], pred func(T) bool) Sequence[T] {
 func (s Sequence[T]) Until(pred func(T) bool) Sequence[T] {
 	return Until(s, pred)
 }
+
+// Skip returns a sequence that skips the first n items of the input sequence,
+// and then produces the rest. Skipping items of an indexed sequence doesn't
+// need to iterate them, and produces another indexed sequence.
+func Skip[T any](s Sequence[T], n int) Sequence[T] {
+	if size, ok := s.Len(); ok {
+		n = min(max(n, 0), size)
+		at := s.indexed.at
+		return GenerateIndexed(size-n, func(i int) T { return at(i + n) })
+	}
+	src := s.Sync()
+	return Derive(src, func(f func(T) error) error {
+		i := 0
+		return src.Each(func(t T) error {
+			if i < n {
+				i++
+				return nil
+			}
+			return f(t)
+		})
+	})
+}
+
+// Skip is a helper method to call the top level function [Skip] on the
+// receiver.
+func (s Sequence[T]) Skip(n int) Sequence[T] {
+	return Skip(s, n)
+}
+
+// DropWhile returns a sequence that skips items of the input sequence while
+// the predicate returns true, and then produces the rest, starting with the
+// first item the predicate returned false for. It's the counterpart of
+// [While].
+func DropWhile[T any](s Sequence[T], pred func(T) bool) Sequence[T] {
+	src := s.Sync()
+	return Derive(src, func(f func(T) error) error {
+		dropping := true
+		return src.Each(func(t T) error {
+			if dropping && pred(t) {
+				return nil
+			}
+			dropping = false
+			return f(t)
+		})
+	})
+}
+
+// DropWhile is a helper method to call the top level function [DropWhile] on
+// the receiver.
+func (s Sequence[T]) DropWhile(pred func(T) bool) Sequence[T] {
+	return DropWhile(s, pred)
+}
+
+// StepBy returns a sequence with every k-th item of the input sequence,
+// starting with the first one. A k <= 0 produces an erroring sequence, as it's
+// likely a mistake.
+func StepBy[T any](s Sequence[T], k int) Sequence[T] {
+	if k <= 0 {
+		return Error[T](fmt.Errorf("called StepBy with invalid step (%v <= 0)", k))
+	}
+	return filterIndexed(s.Sync(), func(i int, _ T) bool { return i%k == 0 })
+}
+
+// StepBy is a helper method to call the top level function [StepBy] on the
+// receiver.
+func (s Sequence[T]) StepBy(k int) Sequence[T] {
+	return StepBy(s, k)
+}
+
+// TakeLast returns a sequence with only the last n items of the input
+// sequence. Only n items are kept in memory at a time, but nothing can be
+// produced until the input ends.
+func TakeLast[T any](s Sequence[T], n int) Sequence[T] {
+	src := s.Sync()
+	return Derive(src, func(f func(T) error) error {
+		buf := newRing[T](max(n, 0))
+		err := src.Each(func(t T) error {
+			buf.push(t)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return buf.each(f)
+	})
+}
+
+// TakeLast is a helper method to call the top level function [TakeLast] on the
+// receiver.
+func (s Sequence[T]) TakeLast(n int) Sequence[T] {
+	return TakeLast(s, n)
+}
+
+// DropLast returns a sequence with all but the last n items of the input
+// sequence. Items are produced n items behind the input, so only n items are
+// kept in memory at a time.
+func DropLast[T any](s Sequence[T], n int) Sequence[T] {
+	src := s.Sync()
+	return Derive(src, func(f func(T) error) error {
+		buf := newRing[T](max(n, 0))
+		return src.Each(func(t T) error {
+			if old, ok := buf.push(t); ok {
+				return f(old)
+			}
+			return nil
+		})
+	})
+}
+
+// DropLast is a helper method to call the top level function [DropLast] on the
+// receiver.
+func (s Sequence[T]) DropLast(n int) Sequence[T] {
+	return DropLast(s, n)
+}
+
+// Slice returns a sequence with the items of the input sequence whose index is
+// in the half-open interval [start, end), like slicing in Go. As in Python, a
+// negative start or end counts back from the end of the sequence, so
+// Slice(s, -3, -1) produces the second and third last items. Use math.MaxInt
+// as the end to produce every item after start.
+//
+// Negative indices need to know where the sequence ends, so they buffer up to
+// -start or -end items.
+func Slice[T any](s Sequence[T], start, end int) Sequence[T] {
+	// -math.MinInt overflows, and counting back math.MaxInt items already
+	// reaches the start of any sequence that can be buffered.
+	start, end = max(start, -math.MaxInt), max(end, -math.MaxInt)
+	switch {
+	case start >= 0 && end >= 0:
+		return Limit(Skip(s, start), max(end-start, 0))
+	case start >= 0:
+		return DropLast(Skip(s, start), -end)
+	case end < 0:
+		return DropLast(TakeLast(s, -start), -end)
+	default:
+		return sliceFromEnd(s, -start, end)
+	}
+}
+
+// sliceFromEnd produces the items of s, among the last n, with an index below
+// end.
+func sliceFromEnd[T any](s Sequence[T], n, end int) Sequence[T] {
+	src := s.Sync()
+	return Derive(src, func(f func(T) error) error {
+		buf := newRing[T](n)
+		count := 0
+		err := src.Each(func(t T) error {
+			buf.push(t)
+			count++
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		i := count - buf.size()
+		return buf.each(func(t T) error {
+			if i >= end {
+				return ErrStopIteration
+			}
+			i++
+			return f(t)
+		})
+	})
+}
+
+// Slice is a helper method to call the top level function [Slice] on the
+// receiver.
+func (s Sequence[T]) Slice(start, end int) Sequence[T] {
+	return Slice(s, start, end)
+}
@@ -1,6 +1,9 @@
 package sequence
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestWhile(t *testing.T) {
 	seq := New(3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5)
@@ -29,3 +32,47 @@ func TestLimit(t *testing.T) {
 
 	compareSequences(t, c, want)
 }
+
+func TestSkips(t *testing.T) {
+	seq := New(3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5)
+
+	testCases := []struct {
+		name string
+		got  Sequence[int]
+		want Sequence[int]
+	}{
+		{"Skip", seq.Skip(8), New(5, 3, 5)},
+		{"SkipAll", seq.Skip(20), New[int]()},
+		{"DropWhile", seq.DropWhile(func(i int) bool { return i < 5 }), New(5, 9, 2, 6, 5, 3, 5)},
+		{"StepBy", seq.StepBy(3), New(3, 1, 2, 3)},
+		{"TakeLast", seq.TakeLast(3), New(5, 3, 5)},
+		{"TakeLastLong", New(1, 2).TakeLast(3), New(1, 2)},
+		{"TakeLastZero", seq.TakeLast(0), New[int]()},
+		{"DropLast", seq.DropLast(8), New(3, 1, 4)},
+		{"DropLastLong", New(1, 2).DropLast(3), New[int]()},
+		{"DropLastZero", New(1, 2).DropLast(0), New(1, 2)},
+		{"Slice", seq.Slice(2, 5), New(4, 1, 5)},
+		{"SliceEmpty", seq.Slice(5, 2), New[int]()},
+		{"SliceToEnd", seq.Slice(8, math.MaxInt), New(5, 3, 5)},
+		{"SliceNegEnd", seq.Slice(2, -6), New(4, 1, 5)},
+		{"SliceNegBoth", seq.Slice(-3, -1), New(5, 3)},
+		{"SliceNegStart", seq.Slice(-4, 9), New(6, 5)},
+		{"SliceNegStartLong", seq.Slice(-20, 2), New(3, 1)},
+		{"SliceMinStart", seq.Slice(math.MinInt, 2), New(3, 1)},
+		{"SliceMinStartNegEnd", seq.Slice(math.MinInt, -1), New(3, 1, 4, 1, 5, 9, 2, 6, 5, 3)},
+		{"SliceMinEnd", seq.Slice(0, math.MinInt), New[int]()},
+		{"SliceMinBoth", seq.Slice(math.MinInt, math.MinInt), New[int]()},
+		{"TakeLastHuge", seq.TakeLast(math.MaxInt), seq},
+		{"DropLastHuge", seq.DropLast(math.MaxInt), New[int]()},
+		{"SliceInfinite", Counter(0).Slice(5, 8), New(5, 6, 7)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, sequenceCompareTest(tc.got, tc.want))
+	}
+
+	t.Run("StepByInvalid", func(t *testing.T) {
+		_ = checkErrorSequence(t, seq.StepBy(0), nil)
+	})
+}
@@ -6,19 +6,17 @@ package sequence
 //
 // MapFilter allows the callback to stop iteration with a generic error, or use
 // ErrStopIteration to simply indicate that no more values should be proceed.
+//
+// Chains of stateless stages built with MapFilter, [Map], [MapErr], [Filter]
+// and [FilterErr] are fused as they're built: consecutive stages that don't
+// change the element type are run by a single callback, instead of each one
+// adding another layer of iteration. The functions are still called in the
+// same order, once per item, so this only affects performance.
 func MapFilter[In, Out any](s Sequence[In], convert func(In) (Out, bool, error)) Sequence[Out] {
-	return Derive(s, func(f func(Out) error) error {
-		return s.Each(func(i In) error {
-			out, ok, err := convert(i)
-			if err != nil {
-				return err
-			}
-			if ok {
-				return f(out)
-			}
-			return nil
-		})
-	})
+	if c, ok := any(convert).(func(Out) (Out, bool, error)); ok {
+		return addStage(any(s).(Sequence[Out]), stage[Out]{convert: c})
+	}
+	return fuseHead(s, head[In, Out]{convert: convert}, nil)
 }
 
 // Map takes in an input sequence and returns a sequence where every input
@@ -26,9 +24,10 @@ func MapFilter[In, Out any](s Sequence[In], convert func(In) (Out, bool, error))
 // be of a different type due to the conversion, but will have the same number
 // of items.
 func Map[In, Out any](s Sequence[In], convert func(In) Out) Sequence[Out] {
-	return MapFilter(s, func(in In) (Out, bool, error) {
-		return convert(in), true, nil
-	})
+	if c, ok := any(convert).(func(Out) Out); ok {
+		return addStage(any(s).(Sequence[Out]), stage[Out]{mapper: c})
+	}
+	return fuseHead(s, head[In, Out]{mapper: convert}, nil)
 }
 
 // MapErr takes in an input sequence and returns a sequence where every input
@@ -49,14 +48,7 @@ func MapErr[In, Out any](s Sequence[In], convert func(In) (Out, error)) Sequence
 // that pass the provided predicate function will be emitted. The ouput
 // sequence will be the same type as the input sequence.
 func Filter[T any](s Sequence[T], pred func(T) bool) Sequence[T] {
-	return Derive[T](s, func(f func(T) error) error {
-		return s.Each(func(t T) error {
-			if pred(t) {
-				return f(t)
-			}
-			return nil
-		})
-	})
+	return addStage(s, stage[T]{pred: pred})
 }
 
 // FilterErr takes an input sequence and creates a sequence where only the
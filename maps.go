@@ -1,11 +1,42 @@
 package sequence
 
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrDuplicateKey is wrapped by the [DuplicateKeyError] returned from
+// [ToMapStrict], so that duplicates can be detected with [errors.Is] without
+// knowing the key type.
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// A DuplicateKeyError is returned by [ToMapStrict] when a key appears more
+// than once in the sequence.
+type DuplicateKeyError[K comparable] struct {
+	Key K
+}
+
+// Error returns a message naming the duplicate key.
+func (e *DuplicateKeyError[K]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrDuplicateKey, e.Key)
+}
+
+// Unwrap returns [ErrDuplicateKey].
+func (e *DuplicateKeyError[K]) Unwrap() error {
+	return ErrDuplicateKey
+}
+
 // FromMap creates a sequence of pairs, where the first item in the pair is a
 // key from the map, and the second item is the value for that key.
-// The sequence is Volatile, since multiple iterations could produce different
-// results.
+//
+// The order of the pairs is unspecified, and may differ between iterations,
+// but the sequence can be iterated over multiple times, each time reflecting
+// the current contents of the map. Use [FromMapSorted] or [FromMapFunc] when
+// a deterministic order is needed.
 func FromMap[K comparable, V any](m map[K]V) Sequence[Pair[K, V]] {
-	return GenerateVolatile(func(f func(Pair[K, V]) error) error {
+	return Generate(func(f func(Pair[K, V]) error) error {
 		for k, v := range m {
 			if err := f(MakePair(k, v)); err != nil {
 				return err
@@ -15,6 +46,44 @@ func FromMap[K comparable, V any](m map[K]V) Sequence[Pair[K, V]] {
 	})
 }
 
+// FromMapSorted is like [FromMap], but the pairs are produced in ascending
+// order of their keys. The keys are collected and sorted at the start of each
+// iteration.
+func FromMapSorted[K cmp.Ordered, V any](m map[K]V) Sequence[Pair[K, V]] {
+	return FromMapFunc(m, cmp.Compare[K])
+}
+
+// FromMapFunc is like [FromMap], but the pairs are produced in the order of
+// their keys as determined by the comparison function, which is the same as
+// used by [slices.SortFunc]. The keys are collected and sorted at the start
+// of each iteration.
+func FromMapFunc[K comparable, V any](m map[K]V, cmp func(a, b K) int) Sequence[Pair[K, V]] {
+	return Generate(func(f func(Pair[K, V]) error) error {
+		keys := make([]K, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		slices.SortFunc(keys, cmp)
+		for _, k := range keys {
+			if err := f(MakePair(k, m[k])); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Keys returns a sequence of the keys of the map, in an unspecified order.
+func Keys[K comparable, V any](m map[K]V) Sequence[K] {
+	return PairSelectA(FromMap(m))
+}
+
+// Values returns a sequence of the values of the map, in an unspecified
+// order.
+func Values[K comparable, V any](m map[K]V) Sequence[V] {
+	return PairSelectB(FromMap(m))
+}
+
 // IntoMap stores the pairs from the given sequence into the provided map.
 // Multiple items with the same key from the sequence will cause the later ones
 // to overwrite earlier ones. If an error occurs generating items from the
@@ -30,10 +99,66 @@ func IntoMap[M ~map[K]V, K comparable, V any](dst M, s Sequence[Pair[K, V]]) err
 // ToMap creates a map from the given sequence of Pairs, and returning it in a
 // Result. The first element in each pair must be comparable, since it must
 // become a map key. Earlier items with the same key will be replaced by later
-// items in the sequence. If an error occurs, the value of the Result will
-// contain all the items processed so far.
+// items in the sequence, see [ToMapMerge] and [ToMapStrict] for alternatives.
+// If an error occurs, the value of the Result will contain all the items
+// processed so far.
 func ToMap[K comparable, V any](s Sequence[Pair[K, V]]) Result[map[K]V] {
 	m := map[K]V{}
 	err := IntoMap(m, s)
 	return MakeResult(m, err)
 }
+
+// ToMapMerge is like [ToMap], but when a key appears more than once the merge
+// function is called with the value already in the map and the new value, and
+// its result is stored instead.
+func ToMapMerge[K comparable, V any](s Sequence[Pair[K, V]], merge func(old, new V) V) Result[map[K]V] {
+	m := map[K]V{}
+	err := EachSimple(s.Sync())(func(p Pair[K, V]) bool {
+		k, v := p.AB()
+		if old, ok := m[k]; ok {
+			v = merge(old, v)
+		}
+		m[k] = v
+		return true
+	})
+	return MakeResult(m, err)
+}
+
+// ToMapStrict is like [ToMap], but fails with a [*DuplicateKeyError] if a key
+// appears more than once in the sequence. As with ToMap, the value of the
+// Result will contain all the items processed before the error.
+func ToMapStrict[K comparable, V any](s Sequence[Pair[K, V]]) Result[map[K]V] {
+	m := map[K]V{}
+	err := Each(s.Sync())(func(p Pair[K, V]) error {
+		k, v := p.AB()
+		if _, ok := m[k]; ok {
+			return &DuplicateKeyError[K]{Key: k}
+		}
+		m[k] = v
+		return nil
+	})
+	return MakeResult(m, err)
+}
+
+// ToMultiMap creates a map from the given sequence of Pairs, where every value
+// is kept in a slice under its key, in the order they were received.
+func ToMultiMap[K comparable, V any](s Sequence[Pair[K, V]]) Result[map[K][]V] {
+	m := map[K][]V{}
+	err := EachSimple(s.Sync())(func(p Pair[K, V]) bool {
+		k, v := p.AB()
+		m[k] = append(m[k], v)
+		return true
+	})
+	return MakeResult(m, err)
+}
+
+// CountBy returns a map with the number of items in the sequence that have
+// each key, as returned by the key function.
+func CountBy[T any, K comparable](s Sequence[T], key func(T) K) Result[map[K]int] {
+	m := map[K]int{}
+	err := EachSimple(s.Sync())(func(t T) bool {
+		m[key(t)]++
+		return true
+	})
+	return MakeResult(m, err)
+}
@@ -0,0 +1,111 @@
+package sequence
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cookieo9/sequence/tools"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestFromMap(t *testing.T) {
+	m := map[string]int{"one": 1, "two": 2, "three": 3, "four": 4}
+	opt := cmpopts.EquateComparable(Pair[string, int]{})
+	want := New[Pair[string, int]]([]Pair[string, int]{
+		{"four", 4}, {"one", 1}, {"three", 3}, {"two", 2},
+	}...)
+
+	t.Run("FromMap", func(t *testing.T) {
+		seq := FromMap(m)
+		if seq.IsVolatile() {
+			t.Error("FromMap returned a volatile sequence")
+		}
+		// Iterate twice to show the sequence can be reused.
+		compareSequences(t, seq.Sort(PairCompare), want, opt)
+		compareSequences(t, seq.Sort(PairCompare), want, opt)
+	})
+
+	t.Run("FromMapSorted", func(t *testing.T) {
+		compareSequences(t, FromMapSorted(m), want, opt)
+	})
+
+	t.Run("FromMapFunc", func(t *testing.T) {
+		byLen := func(a, b string) int {
+			if c := len(a) - len(b); c != 0 {
+				return c
+			}
+			return strings.Compare(a, b)
+		}
+		want := New[Pair[string, int]]([]Pair[string, int]{
+			{"one", 1}, {"two", 2}, {"four", 4}, {"three", 3},
+		}...)
+		compareSequences(t, FromMapFunc(m, byLen), want, opt)
+	})
+
+	t.Run("Keys", func(t *testing.T) {
+		compareSequences(t, SortOrdered(Keys(m)), New("four", "one", "three", "two"))
+	})
+
+	t.Run("Values", func(t *testing.T) {
+		compareSequences(t, SortOrdered(Values(m)), New(1, 2, 3, 4))
+	})
+}
+
+func TestToMapVariants(t *testing.T) {
+	pairs := New(MakePair("a", 1), MakePair("b", 2), MakePair("a", 3))
+
+	t.Run("ToMapMerge", func(t *testing.T) {
+		got, err := ToMapMerge(pairs, tools.Add[int]).Pair()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(got, map[string]int{"a": 4, "b": 2}); diff != "" {
+			t.Errorf("unexpected map (-got, +want):\n%s", diff)
+		}
+	})
+
+	t.Run("ToMapStrict", func(t *testing.T) {
+		got, err := ToMapStrict(pairs).Pair()
+		if !errors.Is(err, ErrDuplicateKey) {
+			t.Errorf("expected ErrDuplicateKey, got %v", err)
+		}
+		var dupErr *DuplicateKeyError[string]
+		if !errors.As(err, &dupErr) || dupErr.Key != "a" {
+			t.Errorf("expected DuplicateKeyError for key a, got %v", err)
+		}
+		if diff := cmp.Diff(got, map[string]int{"a": 1, "b": 2}); diff != "" {
+			t.Errorf("unexpected partial map (-got, +want):\n%s", diff)
+		}
+
+		got, err = ToMapStrict(pairs.Limit(2)).Pair()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(got, map[string]int{"a": 1, "b": 2}); diff != "" {
+			t.Errorf("unexpected map (-got, +want):\n%s", diff)
+		}
+	})
+
+	t.Run("ToMultiMap", func(t *testing.T) {
+		got, err := ToMultiMap(pairs).Pair()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(got, map[string][]int{"a": {1, 3}, "b": {2}}); diff != "" {
+			t.Errorf("unexpected map (-got, +want):\n%s", diff)
+		}
+	})
+
+	t.Run("CountBy", func(t *testing.T) {
+		words := New("apple", "avocado", "banana", "cherry", "blueberry", "apricot")
+		got, err := CountBy(words, func(s string) byte { return s[0] }).Pair()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(got, map[byte]int{'a': 3, 'b': 2, 'c': 1}); diff != "" {
+			t.Errorf("unexpected counts (-got, +want):\n%s", diff)
+		}
+	})
+}
@@ -16,9 +16,13 @@ import "sync"
 //
 // The resulting sequence is neither volatile, nor asynchronous, although an
 // asynchronous input will mean the values stored for playback will be in a
-// non-deterministic order.
+// non-deterministic order. If the input didn't produce an error, the result
+// is indexed.
 func Materialize[T any](s Sequence[T]) Sequence[T] {
 	data, srcErr := ToSlice(s.Sync()).Pair()
+	if srcErr == nil {
+		return FromSlice(data)
+	}
 	return Generate[T](func(f func(T) error) error {
 		for _, x := range data {
 			if err := f(x); err != nil {
@@ -0,0 +1,56 @@
+package sequence
+
+import "sync"
+
+// Merge combines several sequences by iterating all of them concurrently, each
+// in its own goroutine, and passing on their items as they arrive. It's the
+// concurrent counterpart of [Concat], and is useful to fan-in live sequences,
+// such as those from [FromChan].
+//
+// The callback is only ever called from one goroutine at a time, so the
+// output is not asynchronous, but since the order of items depends on timing
+// the output is marked volatile like those from [AsyncPool], and can't be
+// passed to the indexed operators such as [Enumerate].
+//
+// When the consumer stops early, or any input fails, the other inputs are
+// told to stop at their next item, and the first error is returned. A panic
+// in an input is returned as a [*PanicError].
+func Merge[T any](seqs ...Sequence[T]) Sequence[T] {
+	out := GenerateVolatile(func(f func(T) error) error {
+		done := make(chan struct{})
+		defer close(done)
+
+		ch := make(chan Result[T])
+		var wg sync.WaitGroup
+		for _, seq := range seqs {
+			wg.Add(1)
+			go func(seq Sequence[T]) {
+				defer wg.Done()
+				feedTo(done, ch, seq)
+			}(seq)
+		}
+		go func() {
+			wg.Wait()
+			close(ch)
+		}()
+
+		for r := range ch {
+			if r.HasError() {
+				return r.Error()
+			}
+			if err := f(r.Value()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	out.unordered = true
+	return out
+}
+
+// Merge is a helper method which calls the top level function [Merge] to
+// combine the receiver with the given sequence(s).
+func (s Sequence[T]) Merge(others ...Sequence[T]) Sequence[T] {
+	seqs := append([]Sequence[T]{s}, others...)
+	return Merge(seqs...)
+}
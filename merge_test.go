@@ -0,0 +1,65 @@
+package sequence
+
+import (
+	"errors"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMerge(t *testing.T) {
+	a := NumberSequence(0, 100, 2)
+	b := Volatile(NumberSequence(1, 100, 2))
+	merged := a.Merge(b)
+
+	if !merged.IsVolatile() {
+		t.Error("merged sequence isn't volatile")
+	}
+	if merged.IsAsync() {
+		t.Error("merged sequence is async")
+	}
+
+	compareSequences(t, SortOrdered(merged), NumberSequence(0, 100, 1))
+	compareSequences(t, Merge[int](), New[int]())
+}
+
+func TestMergeStops(t *testing.T) {
+	var started, stopped atomic.Int32
+	live := func() Sequence[int] {
+		return Generate(func(f func(int) error) error {
+			started.Add(1)
+			defer stopped.Add(1)
+			for i := 0; ; i++ {
+				if err := f(i); err != nil {
+					return err
+				}
+			}
+		})
+	}
+
+	waitStopped := func(t *testing.T) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for stopped.Load() != started.Load() {
+			if time.Now().After(deadline) {
+				t.Fatalf("inputs didn't stop; started %d, stopped %d", started.Load(), stopped.Load())
+			}
+			runtime.Gosched()
+		}
+	}
+
+	t.Run("Consumer", func(t *testing.T) {
+		n := Count(Merge(live(), live(), live()).Limit(50)).Value()
+		if n != 50 {
+			t.Errorf("unexpected count; got %d, want 50", n)
+		}
+		waitStopped(t)
+	})
+
+	t.Run("Input", func(t *testing.T) {
+		bad := errors.New("bad input")
+		_ = checkErrorSequence(t, Merge(live(), Error[int](bad), live()), bad)
+		waitStopped(t)
+	})
+}
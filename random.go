@@ -0,0 +1,126 @@
+package sequence
+
+import (
+	"container/heap"
+	"math"
+	"math/rand/v2"
+)
+
+// RandomSequence returns an infinite sequence where each item is produced by
+// calling gen with the given random number generator. Each iteration
+// continues drawing from the generator, so iterating again produces different
+// items, unless the generator's source is reset in between.
+func RandomSequence[T any](rng *rand.Rand, gen func(*rand.Rand) T) Sequence[T] {
+	return Generate(func(f func(T) error) error {
+		for {
+			if err := f(gen(rng)); err != nil {
+				return err
+			}
+		}
+	})
+}
+
+// Sample returns a sequence of k items chosen uniformly at random from the
+// input sequence, using reservoir sampling, so the input can be of unknown
+// length while only k items are kept in memory. If the input has fewer than
+// k items, all of them are produced. The order of the sampled items is
+// unspecified.
+//
+// The random choices are made with rng, so a generator with a fixed seed
+// gives reproducible results. Each iteration draws a new sample.
+func Sample[T any](s Sequence[T], k int, rng *rand.Rand) Sequence[T] {
+	src := s.Sync()
+	return Derive(src, func(f func(T) error) error {
+		reservoir := make([]T, 0, max(k, 0))
+		n := 0
+		err := src.Each(func(t T) error {
+			n++
+			if len(reservoir) < k {
+				reservoir = append(reservoir, t)
+			} else if j := rng.IntN(n); j < k {
+				reservoir[j] = t
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return FromSlice(reservoir).Each(f)
+	})
+}
+
+// Shuffle returns a sequence with the items of the input sequence in a random
+// order chosen with rng. Each iteration produces a new order.
+//
+// Note: This function must read and store the entire sequence prior to
+// shuffling it, which may be an issue for large/infinite sequences.
+func Shuffle[T any](s Sequence[T], rng *rand.Rand) Sequence[T] {
+	src := s.Sync()
+	return Derive(src, func(f func(T) error) error {
+		data, err := ToSlice(src).Pair()
+		if err != nil {
+			return err
+		}
+		rng.Shuffle(len(data), func(i, j int) { data[i], data[j] = data[j], data[i] })
+		return FromSlice(data).Each(f)
+	})
+}
+
+// WeightedSample is like [Sample], but the chance of each item being chosen
+// is proportional to its weight, as returned by the weight function. Items
+// with a weight <= 0 are never chosen. Items are sampled without replacement
+// using the A-Res algorithm of Efraimidis and Spirakis, keeping only k items
+// in memory. The sampled items are produced starting with the one that was
+// most strongly favoured by the draw.
+func WeightedSample[T any](s Sequence[T], k int, weight func(T) float64, rng *rand.Rand) Sequence[T] {
+	src := s.Sync()
+	return Derive(src, func(f func(T) error) error {
+		if k <= 0 {
+			return nil
+		}
+		h := &weightedHeap[T]{}
+		err := src.Each(func(t T) error {
+			w := weight(t)
+			if w <= 0 {
+				return nil
+			}
+			key := math.Pow(rng.Float64(), 1/w)
+			if h.Len() < k {
+				heap.Push(h, weightedItem[T]{key: key, item: t})
+			} else if key > h.items[0].key {
+				h.items[0] = weightedItem[T]{key: key, item: t}
+				heap.Fix(h, 0)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		chosen := make([]T, h.Len())
+		for i := len(chosen) - 1; i >= 0; i-- {
+			chosen[i] = heap.Pop(h).(weightedItem[T]).item
+		}
+		return FromSlice(chosen).Each(f)
+	})
+}
+
+type weightedItem[T any] struct {
+	key  float64
+	item T
+}
+
+// weightedHeap is a min-heap of items by key, so the item least likely to stay
+// in the sample is at the top.
+type weightedHeap[T any] struct {
+	items []weightedItem[T]
+}
+
+func (h *weightedHeap[T]) Len() int           { return len(h.items) }
+func (h *weightedHeap[T]) Less(i, j int) bool { return h.items[i].key < h.items[j].key }
+func (h *weightedHeap[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *weightedHeap[T]) Push(x any)         { h.items = append(h.items, x.(weightedItem[T])) }
+func (h *weightedHeap[T]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
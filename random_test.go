@@ -0,0 +1,80 @@
+package sequence
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func newTestRand() *rand.Rand {
+	return rand.New(rand.NewPCG(2023, 12))
+}
+
+func TestRandomSequence(t *testing.T) {
+	got := RandomSequence(newTestRand(), func(r *rand.Rand) int { return r.IntN(100) }).Limit(10)
+	want := RandomSequence(newTestRand(), func(r *rand.Rand) int { return r.IntN(100) }).Limit(10)
+	compareSequences(t, got, want)
+}
+
+func TestSample(t *testing.T) {
+	seq := NumberSequence(0, 1000, 1)
+
+	sample := Sample(seq, 10, newTestRand())
+	compareSequences(t, sample, Sample(seq, 10, newTestRand()))
+
+	if n := Count(sample).Value(); n != 10 {
+		t.Errorf("unexpected sample size; got %d, want 10", n)
+	}
+	if n := Count(Distinct(sample)).Value(); n != 10 {
+		t.Errorf("sample contains duplicates")
+	}
+
+	short := New(1, 2, 3)
+	compareSequences(t, SortOrdered(Sample(short, 5, newTestRand())), short)
+
+	// Every item should be about equally likely to be sampled.
+	rng := newTestRand()
+	counts := make([]int, 10)
+	for i := 0; i < 10_000; i++ {
+		for _, x := range Sample(NumberSequence(0, 10, 1), 3, rng).ToSlice().Value() {
+			counts[x]++
+		}
+	}
+	for i, c := range counts {
+		if c < 2700 || c > 3300 {
+			t.Errorf("item %d sampled %d times, want about 3000", i, c)
+		}
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	seq := NumberSequence(0, 100, 1)
+	shuffled := Shuffle(seq, newTestRand())
+	compareSequences(t, shuffled, Shuffle(seq, newTestRand()))
+	compareSequences(t, SortOrdered(shuffled), seq)
+
+	if got := shuffled.ToSlice().Value(); got[0] == 0 && got[1] == 1 && got[2] == 2 {
+		t.Errorf("shuffle didn't change the order: %v", got)
+	}
+}
+
+func TestWeightedSample(t *testing.T) {
+	weights := map[string]float64{"never": 0, "rare": 1, "common": 10}
+	weight := func(s string) float64 { return weights[s] }
+	seq := New("never", "rare", "common")
+
+	rng := newTestRand()
+	counts := map[string]int{}
+	for i := 0; i < 10_000; i++ {
+		first := First(WeightedSample(seq, 1, weight, rng)).Value()
+		counts[first]++
+	}
+	if counts["never"] != 0 {
+		t.Errorf("zero weight item sampled %d times", counts["never"])
+	}
+	if c := counts["common"]; c < 8800 || c > 9400 {
+		t.Errorf("common item sampled %d times, want about 9090", c)
+	}
+
+	both := WeightedSample(seq, 5, weight, newTestRand())
+	compareSequences(t, SortOrdered(both), New("common", "rare"))
+}
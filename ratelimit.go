@@ -0,0 +1,117 @@
+package sequence
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateLimit returns a sequence that passes on the items of the input at most
+// perSecond items per second on average, allowing bursts of up to burst items
+// to pass through without waiting. It's a token bucket that starts full, and
+// a burst below 1 is treated as 1.
+//
+// Waiting happens before each item is passed on, in the goroutine calling
+// the callback, so it also limits the rate of the workers of an [AsyncPool].
+func RateLimit[T any](s Sequence[T], perSecond float64, burst int) Sequence[T] {
+	return RateLimitClock(SystemClock, s, perSecond, burst)
+}
+
+// RateLimitClock is like [RateLimit], but uses the given [Clock] to measure and
+// wait for time.
+func RateLimitClock[T any](c Clock, s Sequence[T], perSecond float64, burst int) Sequence[T] {
+	if perSecond <= 0 {
+		return Error[T](fmt.Errorf("called RateLimit with invalid rate (%v <= 0)", perSecond))
+	}
+	capacity := float64(max(burst, 1))
+
+	return Derive(s, func(f func(T) error) error {
+		var lock sync.Mutex
+		tokens := capacity
+		last := c.Now()
+
+		return s.Each(func(t T) error {
+			lock.Lock()
+			now := c.Now()
+			tokens = min(capacity, tokens+now.Sub(last).Seconds()*perSecond)
+			last = now
+			tokens--
+			wait := time.Duration(-tokens / perSecond * float64(time.Second))
+			lock.Unlock()
+
+			if wait > 0 {
+				c.Sleep(wait)
+			}
+			return f(t)
+		})
+	})
+}
+
+// RateLimit is a helper method to call the package function [RateLimit] on
+// the receiver.
+func (s Sequence[T]) RateLimit(perSecond float64, burst int) Sequence[T] {
+	return RateLimit(s, perSecond, burst)
+}
+
+// Throttle returns a sequence that passes on the items of the input with at
+// least the given interval between them. The first item is passed on without
+// waiting. Items are delayed rather than dropped.
+func Throttle[T any](s Sequence[T], interval time.Duration) Sequence[T] {
+	return ThrottleClock(SystemClock, s, interval)
+}
+
+// ThrottleClock is like [Throttle], but uses the given [Clock] to measure and
+// wait for time.
+func ThrottleClock[T any](c Clock, s Sequence[T], interval time.Duration) Sequence[T] {
+	return Derive(s, func(f func(T) error) error {
+		var (
+			lock sync.Mutex
+			next time.Time
+		)
+
+		return s.Each(func(t T) error {
+			lock.Lock()
+			now := c.Now()
+			slot := now
+			if slot.Before(next) {
+				slot = next
+			}
+			next = slot.Add(interval)
+			lock.Unlock()
+
+			if wait := slot.Sub(now); wait > 0 {
+				c.Sleep(wait)
+			}
+			return f(t)
+		})
+	})
+}
+
+// Throttle is a helper method to call the package function [Throttle] on the
+// receiver.
+func (s Sequence[T]) Throttle(interval time.Duration) Sequence[T] {
+	return Throttle(s, interval)
+}
+
+// Delay returns a sequence that waits for the given duration before passing
+// on each item of the input. Unlike [Throttle], the wait doesn't account for
+// time spent elsewhere, so it adds a fixed delay per item.
+func Delay[T any](s Sequence[T], d time.Duration) Sequence[T] {
+	return DelayClock(SystemClock, s, d)
+}
+
+// DelayClock is like [Delay], but uses the given [Clock] to wait.
+func DelayClock[T any](c Clock, s Sequence[T], d time.Duration) Sequence[T] {
+	return Derive(s, func(f func(T) error) error {
+		return s.Each(func(t T) error {
+			c.Sleep(d)
+			return f(t)
+		})
+	})
+}
+
+// Delay is a helper method to call the package function [Delay] on the
+// receiver.
+func (s Sequence[T]) Delay(d time.Duration) Sequence[T] {
+	return Delay(s, d)
+}
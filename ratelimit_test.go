@@ -0,0 +1,68 @@
+package sequence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRateLimits(t *testing.T) {
+	start := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	ms := time.Millisecond
+
+	testCases := []struct {
+		name string
+		seq  func(Clock, Sequence[int]) Sequence[int]
+		want []time.Duration
+	}{
+		{
+			name: "RateLimit",
+			seq: func(c Clock, s Sequence[int]) Sequence[int] {
+				return RateLimitClock(c, s, 2, 2)
+			},
+			want: []time.Duration{0, 0, 500 * ms, 1000 * ms, 1500 * ms},
+		},
+		{
+			name: "Throttle",
+			seq: func(c Clock, s Sequence[int]) Sequence[int] {
+				return ThrottleClock(c, s, 300*ms)
+			},
+			want: []time.Duration{0, 300 * ms, 600 * ms, 900 * ms, 1200 * ms},
+		},
+		{
+			name: "Delay",
+			seq: func(c Clock, s Sequence[int]) Sequence[int] {
+				return DelayClock(c, s, 200*ms)
+			},
+			want: []time.Duration{200 * ms, 400 * ms, 600 * ms, 800 * ms, 1000 * ms},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewFakeClock(start)
+			seq := tc.seq(c, New(1, 2, 3, 4, 5))
+
+			var got []time.Duration
+			var err error
+			runWithClock(c, 100*ms, func() {
+				err = Each(seq)(func(int) error {
+					got = append(got, c.Now().Sub(start))
+					return nil
+				})
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected item times (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRateLimitInvalid(t *testing.T) {
+	_ = checkErrorSequence(t, New(1, 2, 3).RateLimit(0, 1), nil)
+}
@@ -0,0 +1,55 @@
+package sequence
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// A PanicError is produced when a panic is recovered while processing a
+// sequence. It holds the value passed to panic, and the stack trace of the
+// goroutine at the point it was recovered.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+// Error returns a message containing the panic value and the stack trace.
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("recovered panic: %v\n\n%s", p.Value, p.Stack)
+}
+
+// Unwrap returns the panic value if it was an error, so that [errors.Is] and
+// [errors.As] can look through a PanicError.
+func (p *PanicError) Unwrap() error {
+	if err, ok := p.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// catchPanic is deferred to convert a panic into a *PanicError stored in err.
+func catchPanic(err *error) {
+	if r := recover(); r != nil {
+		*err = &PanicError{Value: r, Stack: debug.Stack()}
+	}
+}
+
+// Recover returns a sequence that converts any panic that happens while
+// iterating the input sequence into a [*PanicError] returned from Each. This
+// covers the generator and upstream stages, like the convert function of a
+// [Map], and also any downstream callbacks running on the same goroutine.
+//
+// Panics in goroutines started by the input (e.g. [AsyncPool] workers) need
+// to be handled there, since recover only works on the panicking goroutine.
+func Recover[T any](s Sequence[T]) Sequence[T] {
+	return Derive(s, func(f func(T) error) (err error) {
+		defer catchPanic(&err)
+		return s.Each(f)
+	})
+}
+
+// Recover is a helper method to call the package function [Recover] on the
+// receiver.
+func (s Sequence[T]) Recover() Sequence[T] {
+	return Recover(s)
+}
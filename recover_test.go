@@ -0,0 +1,106 @@
+package sequence
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRecover(t *testing.T) {
+	boom := errors.New("boom")
+
+	testCases := []struct {
+		name string
+		seq  Sequence[int]
+	}{
+		{"Map", Recover(Map(New(1, 2, 3), func(i int) int {
+			if i == 2 {
+				panic(boom)
+			}
+			return i
+		}))},
+		{"AsyncPool", Map(NumberSequence(0, 100, 1).AsyncPool(4), func(i int) int {
+			if i == 50 {
+				panic(boom)
+			}
+			return i
+		})},
+		{"Zip", PairSelectA(Zip(New(1, 2, 3), Generate(func(f func(int) error) error {
+			panic(boom)
+		})))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkErrorSequence(t, tc.seq, boom)
+			var pErr *PanicError
+			if !errors.As(err, &pErr) {
+				t.Fatalf("expected a *PanicError, got %T", err)
+			}
+			if pErr.Value != boom {
+				t.Errorf("unexpected panic value; got %v, want %v", pErr.Value, boom)
+			}
+			if !strings.Contains(string(pErr.Stack), "recover_test.go") {
+				t.Errorf("stack trace doesn't contain the panic site:\n%s", pErr.Stack)
+			}
+		})
+	}
+}
+
+func TestRecoverNonError(t *testing.T) {
+	seq := Generate(func(f func(int) error) error { panic("not an error") }).Recover()
+	err := checkErrorSequence(t, seq, nil)
+	var pErr *PanicError
+	if !errors.As(err, &pErr) {
+		t.Fatalf("expected a *PanicError, got %T", err)
+	}
+	if pErr.Unwrap() != nil {
+		t.Errorf("unexpected unwrapped error: %v", pErr.Unwrap())
+	}
+}
+
+func TestRecoverChans(t *testing.T) {
+	boom := errors.New("boom")
+	panicky := func() Sequence[int] {
+		return Map(New(1, 2, 3), func(i int) int {
+			if i == 2 {
+				panic(boom)
+			}
+			return i
+		})
+	}
+	check := func(t *testing.T, err error) {
+		t.Helper()
+		var pErr *PanicError
+		if !errors.As(err, &pErr) || pErr.Value != boom {
+			t.Errorf("expected a *PanicError holding %v, got %v", boom, err)
+		}
+	}
+
+	t.Run("ToChanErr", func(t *testing.T) {
+		ch, eCh := ToChanErr(panicky())
+		for range ch {
+		}
+		check(t, <-eCh)
+	})
+
+	t.Run("ToChanPair", func(t *testing.T) {
+		var err error
+		for p := range ToChanPair(panicky()) {
+			if p.B() != nil {
+				err = p.B()
+			}
+		}
+		check(t, err)
+	})
+
+	t.Run("ToChanCtx", func(t *testing.T) {
+		ch, ctx := ToChanCtx(context.Background(), panicky())
+		for range ch {
+		}
+		<-ctx.Done()
+		check(t, context.Cause(ctx))
+	})
+}
@@ -0,0 +1,129 @@
+package sequence
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// A RetryPolicy describes how [MapRetry] handles a failing conversion. The
+// zero value makes a single attempt with no retries.
+type RetryPolicy struct {
+	// Attempts is the maximum number of times the function is called for a
+	// single element, including the first call. Values below 1 are treated
+	// as 1.
+	Attempts int
+
+	// Delay is the time to wait before the first retry. Each following retry
+	// waits Multiplier times longer than the previous one, up to MaxDelay.
+	Delay time.Duration
+
+	// MaxDelay caps the backoff delay. A value <= 0 means there is no cap.
+	MaxDelay time.Duration
+
+	// Multiplier is the growth factor for the delay between retries. Values
+	// below 1 are treated as 2, which gives a standard exponential backoff.
+	Multiplier float64
+
+	// Jitter is the fraction of each delay that is randomized, e.g. 0.1
+	// spreads the delay over +/- 10% of its value. It is clamped to [0,1].
+	Jitter float64
+
+	// Retryable reports whether an error should be retried. If nil, all
+	// errors are retried. [ErrStopIteration] is never retried.
+	Retryable func(error) bool
+
+	// Clock is used to wait between attempts. If nil, [SystemClock] is used.
+	// Tests can use a [FakeClock] to avoid actually sleeping.
+	Clock Clock
+
+	// Rand returns a random number in [0,1) to apply jitter. If nil,
+	// [rand.Float64] is used.
+	Rand func() float64
+}
+
+// backoff returns how long to wait before the given retry, where retry 0 is
+// the first retry after the initial attempt failed.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	mult := p.Multiplier
+	if mult < 1 {
+		mult = 2
+	}
+	// Without a MaxDelay, the delay is still capped at the longest Duration,
+	// so that it can't overflow when converted back.
+	limit := float64(math.MaxInt64)
+	if p.MaxDelay > 0 {
+		limit = float64(p.MaxDelay)
+	}
+	d := float64(p.Delay)
+	for i := 0; i < retry; i++ {
+		d *= mult
+		if d >= limit {
+			break
+		}
+	}
+	d = min(d, limit)
+	if jitter := min(max(p.Jitter, 0), 1); jitter > 0 {
+		rnd := p.Rand
+		if rnd == nil {
+			rnd = rand.Float64
+		}
+		d += d * jitter * (2*rnd() - 1)
+	}
+	if d >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
+
+func (p RetryPolicy) retryable(err error) bool {
+	if errors.Is(err, ErrStopIteration) {
+		return false
+	}
+	return p.Retryable == nil || p.Retryable(err)
+}
+
+// Do calls f until it succeeds, the policy runs out of attempts, or f returns
+// an error the policy doesn't consider retryable. The last error from f is
+// returned, annotated with the number of attempts made if it was retried.
+func (p RetryPolicy) Do(f func() error) error {
+	c := p.Clock
+	if c == nil {
+		c = SystemClock
+	}
+	attempts := max(p.Attempts, 1)
+	for i := 1; ; i++ {
+		err := f()
+		if err == nil || !p.retryable(err) {
+			return err
+		}
+		if i >= attempts {
+			if i == 1 {
+				return err
+			}
+			return fmt.Errorf("giving up after %d attempts: %w", i, err)
+		}
+		c.Sleep(p.backoff(i - 1))
+	}
+}
+
+// MapRetry is like [MapErr], but failing calls to the convert function are
+// retried according to the given policy. Once the policy gives up, the last
+// error stops iteration like it would for MapErr.
+//
+// Retries happen in the goroutine that received the element, so when the
+// input is produced by [AsyncPool], an element waiting on a retry only holds
+// up its own worker and the others continue processing.
+func MapRetry[In, Out any](s Sequence[In], convert func(In) (Out, error), policy RetryPolicy) Sequence[Out] {
+	return MapErr(s, func(in In) (Out, error) {
+		var out Out
+		err := policy.Do(func() error {
+			var err error
+			out, err = convert(in)
+			return err
+		})
+		return out, err
+	})
+}
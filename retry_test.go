@@ -0,0 +1,132 @@
+package sequence
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMapRetry(t *testing.T) {
+	errFlaky := errors.New("flaky")
+
+	// flaky fails the first n calls for each input
+	flaky := func(n int) func(string) (int, error) {
+		var lock sync.Mutex
+		calls := map[string]int{}
+		return func(s string) (int, error) {
+			lock.Lock()
+			calls[s]++
+			c := calls[s]
+			lock.Unlock()
+			if c <= n {
+				return 0, errFlaky
+			}
+			return strconv.Atoi(s)
+		}
+	}
+
+	t.Run("Recovers", func(t *testing.T) {
+		start := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+		c := NewFakeClock(start)
+		policy := RetryPolicy{
+			Attempts: 4,
+			Delay:    time.Second,
+			MaxDelay: 3 * time.Second,
+			Clock:    c,
+		}
+		var got []int
+		var err error
+		runWithClock(c, time.Second, func() {
+			got, err = MapRetry(New("1", "2"), flaky(3), policy).ToSlice().Pair()
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(got, []int{1, 2}); diff != "" {
+			t.Errorf("unexpected results (-got, +want):\n%s", diff)
+		}
+
+		// Each input waits 1s, 2s, then 3s (capped) before its retries.
+		if got, want := c.Now().Sub(start), 12*time.Second; got != want {
+			t.Errorf("unexpected time spent retrying; got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("GivesUp", func(t *testing.T) {
+		policy := RetryPolicy{Attempts: 3}
+		got := MapRetry(New("1", "2"), flaky(3), policy)
+		_ = checkErrorSequence(t, got, errFlaky)
+	})
+
+	t.Run("NotRetryable", func(t *testing.T) {
+		calls := 0
+		policy := RetryPolicy{
+			Attempts:  5,
+			Retryable: func(err error) bool { return !errors.Is(err, strconv.ErrSyntax) },
+		}
+		got := MapRetry(New("abc"), func(s string) (int, error) {
+			calls++
+			return strconv.Atoi(s)
+		}, policy)
+		_ = checkErrorSequence(t, got, strconv.ErrSyntax)
+		if calls != 1 {
+			t.Errorf("unexpected number of calls; got %d, want 1", calls)
+		}
+	})
+
+	t.Run("Async", func(t *testing.T) {
+		policy := RetryPolicy{Attempts: 2}
+		numbers := Map(NumberSequence(0, 100, 1), strconv.Itoa)
+		got := MapRetry(numbers.AsyncPool(8), flaky(1), policy)
+		sum, err := Sum(got).Pair()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if want := euler(0, 99); sum != want {
+			t.Errorf("unexpected sum; got %d, want %d", sum, want)
+		}
+	})
+}
+
+func TestRetryPolicyJitter(t *testing.T) {
+	policy := RetryPolicy{
+		Delay:  time.Second,
+		Jitter: 0.5,
+		Rand:   func() float64 { return 0 },
+	}
+	if got, want := policy.backoff(0), 500*time.Millisecond; got != want {
+		t.Errorf("unexpected jittered delay; got %v, want %v", got, want)
+	}
+}
+
+func TestRetryPolicyOverflow(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy RetryPolicy
+	}{
+		{"Uncapped", RetryPolicy{Delay: time.Second}},
+		{"UncappedJitter", RetryPolicy{Delay: time.Second, Jitter: 1, Rand: func() float64 { return 0.999 }}},
+		{"Multiplier", RetryPolicy{Delay: time.Second, Multiplier: 1000}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			prev := time.Duration(0)
+			for _, retry := range []int{0, 10, 30, 40, 63, 64, 1000} {
+				got := tc.policy.backoff(retry)
+				if got < prev {
+					t.Errorf("backoff(%d) = %v, shorter than an earlier retry (%v)", retry, got, prev)
+				}
+				prev = got
+			}
+			if got := tc.policy.backoff(1000); got != math.MaxInt64 {
+				t.Errorf("backoff(1000) = %v, want the longest Duration", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package sequence
+
+// ring is a bounded FIFO buffer, used to hold the last few items of a
+// sequence. Its storage grows as items are added, up to the capacity, so a
+// large capacity only costs memory if the sequence is that long.
+type ring[T any] struct {
+	items    []T
+	capacity int
+	start    int
+}
+
+func newRing[T any](capacity int) *ring[T] {
+	return &ring[T]{capacity: capacity}
+}
+
+func (r *ring[T]) size() int { return len(r.items) }
+
+func (r *ring[T]) full() bool { return len(r.items) == r.capacity }
+
+// push adds t to the end of the ring. If the ring is full, the oldest item is
+// removed to make room, and returned with true.
+func (r *ring[T]) push(t T) (T, bool) {
+	if r.capacity <= 0 {
+		return t, true
+	}
+	if r.full() {
+		old := r.items[r.start]
+		r.items[r.start] = t
+		r.start = (r.start + 1) % len(r.items)
+		return old, true
+	}
+	r.items = append(r.items, t)
+	return *new(T), false
+}
+
+// each calls f with the items in the ring from oldest to newest, stopping at
+// the first error.
+func (r *ring[T]) each(f func(T) error) error {
+	for i := range r.items {
+		if err := f(r.items[(r.start+i)%len(r.items)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -18,9 +18,19 @@ var (
 // sequence can only be used to fetch the values stored within, but the
 // sequence itself can't be used to make changes.
 type Sequence[T any] struct {
-	source   func(func(T) error) error
-	volatile bool
-	async    bool
+	source    func(func(T) error) error
+	volatile  bool
+	async     bool
+	unordered bool
+	indexed   *indexer[T]
+	pipeline  *pipeline[T]
+}
+
+// indexer provides random access to the items of a sequence whose length is
+// known up front.
+type indexer[T any] struct {
+	size int
+	at   func(int) T
 }
 
 // IsVolatile returns true if the sequence is volatile, meaning that it should
@@ -33,6 +43,31 @@ func (s Sequence[T]) IsVolatile() bool { return s.volatile }
 // during iteration.
 func (s Sequence[T]) IsAsync() bool { return s.async }
 
+// IsIndexed returns true if the sequence has a known length and supports
+// random access to its items with [Sequence.At]. Indexed sequences come from
+// sources such as [FromSlice], [Repeat], and [GenerateIndexed], and let
+// operations like [Count], [Limit], [Skip] and [Reverse] take shortcuts.
+func (s Sequence[T]) IsIndexed() bool { return s.indexed != nil }
+
+// Len returns the number of items in the sequence, and true, if the sequence
+// is indexed. Otherwise it returns 0 and false, and the length can only be
+// found by iterating the sequence, e.g. with [Count].
+func (s Sequence[T]) Len() (int, bool) {
+	if s.indexed == nil {
+		return 0, false
+	}
+	return s.indexed.size, true
+}
+
+// At returns the item at index i, and true, if the sequence is indexed and i
+// is in range. Otherwise it returns a zero value and false.
+func (s Sequence[T]) At(i int) (T, bool) {
+	if s.indexed == nil || i < 0 || i >= s.indexed.size {
+		return *new(T), false
+	}
+	return s.indexed.at(i), true
+}
+
 // Each iterates over every item in the sequence calling the passed callback
 // with each item. An error returned from the callback, or one that arose from
 // the processing of the sequence will be returned if they arise and iteration
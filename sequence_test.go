@@ -31,3 +31,64 @@ func TestSequence(t *testing.T) {
 		t.Errorf("unexpected diff in copy copy (-got, +want): \n%s", diff)
 	}
 }
+
+func TestIndexedSequences(t *testing.T) {
+	testCases := []struct {
+		name    string
+		seq     Sequence[int]
+		indexed bool
+		want    []int
+	}{
+		{"FromSlice", New(3, 1, 4, 1, 5), true, []int{3, 1, 4, 1, 5}},
+		{"Repeat", Repeat(7, 3), true, []int{7, 7, 7}},
+		{"NumberSequence", NumberSequence(2, 11, 3), true, []int{2, 5, 8}},
+		{"NumberSequenceEmpty", NumberSequence(5, 0, 1), true, nil},
+		{"Limit", New(3, 1, 4, 1, 5).Limit(2), true, []int{3, 1}},
+		{"Skip", New(3, 1, 4, 1, 5).Skip(3), true, []int{1, 5}},
+		{"SkipAll", New(3, 1, 4).Skip(5), true, nil},
+		{"Reverse", NumberSequence(0, 5, 1).Reverse(), true, []int{4, 3, 2, 1, 0}},
+		{"Materialize", Counter(0).Limit(3).Materialize(), true, []int{0, 1, 2}},
+		{"Counter", Counter(0).Limit(3), false, []int{0, 1, 2}},
+		{"Map", Map(New(1, 2), func(i int) int { return i }), false, []int{1, 2}},
+		{"Volatile", Volatile(New(1, 2)), false, []int{1, 2}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.seq.IsIndexed(); got != tc.indexed {
+				t.Fatalf("unexpected IsIndexed; got %v, want %v", got, tc.indexed)
+			}
+			got := tc.seq.ToSlice().Value()
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected items (-got, +want):\n%s", diff)
+			}
+			if !tc.indexed {
+				return
+			}
+			if n, _ := tc.seq.Len(); n != len(tc.want) {
+				t.Errorf("unexpected Len; got %d, want %d", n, len(tc.want))
+			}
+			for i, want := range tc.want {
+				if got, ok := tc.seq.At(i); !ok || got != want {
+					t.Errorf("unexpected At(%d); got %v (%v), want %v", i, got, ok, want)
+				}
+			}
+			if _, ok := tc.seq.At(len(tc.want)); ok {
+				t.Errorf("At(%d) should be out of range", len(tc.want))
+			}
+		})
+	}
+}
+
+func TestNumberSequenceLen(t *testing.T) {
+	if _, ok := NumberSequence(0.0, 1.0, 0.1).Len(); ok {
+		t.Error("floating point NumberSequence shouldn't be indexed")
+	}
+	if n, ok := NumberSequence[int8](-100, 100, 1).Len(); ok {
+		t.Errorf("overflowing NumberSequence shouldn't be indexed, got length %d", n)
+	}
+	if n, _ := NumberSequence[uint8](0, 255, 2).Len(); n != 128 {
+		t.Errorf("unexpected length; got %d, want 128", n)
+	}
+}
@@ -0,0 +1,168 @@
+package sequence
+
+// Set is a generic set implementation using a map. It provides methods for
+// adding, removing, and checking membership of elements, as well as the usual
+// set operations. The Set is not thread-safe, but the zero value is usable.
+type Set[T comparable] map[T]struct{}
+
+// NewSet creates a new generic Set from the provided items.
+func NewSet[T comparable](items ...T) Set[T] {
+	var s Set[T]
+	s.AddMany(items...)
+	return s
+}
+
+// init initializes the set if it is currently nil.
+// It is used internally to ensure the set value is allocated before use.
+func (s *Set[T]) init() {
+	if *s == nil {
+		*s = make(Set[T])
+	}
+}
+
+// Has reports whether x is a member of the Set.
+func (s Set[T]) Has(x T) bool {
+	_, exist := s[x]
+	return exist
+}
+
+// Add adds an element x to the set s. If x is already in s, Add returns false.
+// Otherwise, Add initializes s if needed and inserts x, returning true.
+func (s *Set[T]) Add(x T) bool {
+	if s.Has(x) {
+		return false
+	}
+	s.init()
+	(*s)[x] = struct{}{}
+	return true
+}
+
+// AddMany adds multiple elements xs to the set s. It iterates over xs and calls
+// Add on each element. AddMany allows adding multiple elements in a single call
+// instead of multiple individual Add calls.
+func (s *Set[T]) AddMany(xs ...T) {
+	for _, x := range xs {
+		s.Add(x)
+	}
+}
+
+// Remove removes x from the set, returning true if it was present.
+func (s Set[T]) Remove(x T) bool {
+	if !s.Has(x) {
+		return false
+	}
+	delete(s, x)
+	return true
+}
+
+// Len returns the number of elements in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Union returns a new set containing the elements that are in either s or
+// other.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	out := make(Set[T], max(len(s), len(other)))
+	for x := range s {
+		out[x] = struct{}{}
+	}
+	for x := range other {
+		out[x] = struct{}{}
+	}
+	return out
+}
+
+// Intersection returns a new set containing the elements that are in both s
+// and other.
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	out := make(Set[T])
+	for x := range small {
+		if large.Has(x) {
+			out[x] = struct{}{}
+		}
+	}
+	return out
+}
+
+// Difference returns a new set containing the elements of s that are not in
+// other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	out := make(Set[T])
+	for x := range s {
+		if !other.Has(x) {
+			out[x] = struct{}{}
+		}
+	}
+	return out
+}
+
+// ToSet returns a Result holding a set of the items in the sequence. If an
+// error occurs, the value of the Result will contain all the items processed
+// so far.
+func ToSet[T comparable](s Sequence[T]) Result[Set[T]] {
+	set := make(Set[T])
+	err := EachSimple(s.Sync())(func(t T) bool {
+		set.Add(t)
+		return true
+	})
+	return MakeResult(set, err)
+}
+
+// FromSet returns a sequence of the elements in the set. Like [FromMap] the
+// order is unspecified, and each iteration reflects the current contents of
+// the set.
+func FromSet[T comparable](set Set[T]) Sequence[T] {
+	return Keys(set)
+}
+
+// Distinct returns a sequence containing the items of the input sequence, but
+// skipping any item equal to one already produced during the iteration.
+func Distinct[T comparable](s Sequence[T]) Sequence[T] {
+	src := s.Sync()
+	return Derive(src, func(f func(T) error) error {
+		var seen Set[T]
+		return src.Each(func(t T) error {
+			if seen.Add(t) {
+				return f(t)
+			}
+			return nil
+		})
+	})
+}
+
+// Intersect returns a sequence of the distinct items of a that are also in b,
+// in the order they appear in a. The b sequence is read completely into a
+// [Set] at the start of each iteration.
+func Intersect[T comparable](a, b Sequence[T]) Sequence[T] {
+	return setFilter(a, b, true)
+}
+
+// Except returns a sequence of the distinct items of a that are not in b, in
+// the order they appear in a. The b sequence is read completely into a [Set]
+// at the start of each iteration.
+func Except[T comparable](a, b Sequence[T]) Sequence[T] {
+	return setFilter(a, b, false)
+}
+
+func setFilter[T comparable](a, b Sequence[T], keep bool) Sequence[T] {
+	src := Distinct(a)
+	out := Derive(src, func(f func(T) error) error {
+		set, err := ToSet(b).Pair()
+		if err != nil {
+			return err
+		}
+		return src.Each(func(t T) error {
+			if set.Has(t) == keep {
+				return f(t)
+			}
+			return nil
+		})
+	})
+	out.volatile = a.volatile || b.volatile
+	return out
+}
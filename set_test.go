@@ -0,0 +1,62 @@
+package sequence
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSet(t *testing.T) {
+	a := NewSet(1, 2, 3, 4)
+	b := NewSet(3, 4, 5)
+
+	testCases := []struct {
+		name string
+		got  Set[int]
+		want Set[int]
+	}{
+		{"Union", a.Union(b), NewSet(1, 2, 3, 4, 5)},
+		{"Intersection", a.Intersection(b), NewSet(3, 4)},
+		{"Difference", a.Difference(b), NewSet(1, 2)},
+		{"ToSet", ToSet(New(1, 1, 2, 3, 4, 4)).Value(), a},
+		{"FromSet", ToSet(FromSet(b)).Value(), b},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.got, tc.want); diff != "" {
+				t.Errorf("unexpected set (-got, +want):\n%s", diff)
+			}
+		})
+	}
+
+	var s Set[int]
+	if s.Remove(1) || s.Len() != 0 {
+		t.Error("unexpected removal from empty set")
+	}
+	s.AddMany(1, 2)
+	if !s.Remove(1) || s.Has(1) || s.Len() != 1 {
+		t.Errorf("unexpected set after removal: %v", s)
+	}
+}
+
+func TestSetOperators(t *testing.T) {
+	seq := New(3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5)
+	other := New(5, 3, 7, 3)
+
+	t.Run("Distinct", sequenceCompareTest(Distinct(seq), New(3, 1, 4, 5, 9, 2, 6)))
+	t.Run("Intersect", sequenceCompareTest(Intersect(seq, other), New(3, 5)))
+	t.Run("Except", sequenceCompareTest(Except(seq, other), New(1, 4, 9, 2, 6)))
+
+	t.Run("Error", func(t *testing.T) {
+		bad := errors.New("bad set")
+		_ = checkErrorSequence(t, Except(seq, Error[int](bad)), bad)
+	})
+
+	t.Run("Volatile", func(t *testing.T) {
+		if !Intersect(seq, Volatile(other)).IsVolatile() {
+			t.Error("Intersect with a volatile input isn't volatile")
+		}
+	})
+}
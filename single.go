@@ -19,16 +19,9 @@ func Infinite[T any](t T) Sequence[T] {
 }
 
 // Repeat generates a sequence where the given item is returns a fixed number
-// of times.
+// of times. The sequence is indexed.
 func Repeat[T any](t T, n int) Sequence[T] {
-	return Generate(func(f func(T) error) error {
-		for i := 0; i < n; i++ {
-			if err := f(t); err != nil {
-				return err
-			}
-		}
-		return nil
-	})
+	return GenerateIndexed(n, func(int) T { return t })
 }
 
 // Single returns a sequence where the given value is returned once.
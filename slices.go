@@ -1,5 +1,7 @@
 package sequence
 
+import "slices"
+
 // ToSlice is a utility method that calls the top level function version of
 // [ToSlice].
 func (s Sequence[T]) ToSlice() Result[[]T] {
@@ -9,7 +11,13 @@ func (s Sequence[T]) ToSlice() Result[[]T] {
 // Append processes the given sequence such that each item returned is
 // appended to the provided destination slice. The resulting final
 // slice is returned.
+//
+// If the length of the sequence is known (see [Sequence.Len]), the destination
+// slice is grown once up front.
 func Append[S ~[]T, T any](dst S, s Sequence[T]) Result[S] {
+	if n, ok := s.Len(); ok {
+		dst = slices.Grow(dst, n)
+	}
 	err := EachSimple(s.Sync())(func(t T) bool {
 		dst = append(dst, t)
 		return true
@@ -25,9 +33,10 @@ func ToSlice[T any](s Sequence[T]) Result[[]T] {
 
 // FromSlice returns a sequence where the elements of the slice are returned.
 // The source slice is reference from the sequence so certain changes to that
-// slice may affect the sequence.
+// slice may affect the sequence. The sequence is indexed, with the length of
+// the slice at the time FromSlice was called.
 func FromSlice[T any](items []T) Sequence[T] {
-	return Generate(func(f func(T) error) error {
+	s := Generate(func(f func(T) error) error {
 		for _, x := range items {
 			if err := f(x); err != nil {
 				return err
@@ -35,4 +44,6 @@ func FromSlice[T any](items []T) Sequence[T] {
 		}
 		return nil
 	})
+	s.indexed = &indexer[T]{size: len(items), at: func(i int) T { return items[i] }}
+	return s
 }
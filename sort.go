@@ -64,8 +64,14 @@ func (s Sequence[T]) SortStable(cmp func(T, T) int) Sequence[T] {
 // in reverse order.
 //
 // Note: This function must read and store the entire sequence prior to
-// reversing it, which may be an issue for large/infinite sequences.
+// reversing it, which may be an issue for large/infinite sequences. Indexed
+// sequences are the exception, since they can be read backwards, so the
+// result is another indexed sequence that doesn't copy anything.
 func Reverse[T any](s Sequence[T]) Sequence[T] {
+	if n, ok := s.Len(); ok {
+		at := s.indexed.at
+		return GenerateIndexed(n, func(i int) T { return at(n - 1 - i) })
+	}
 	data, err := s.ToSlice().Pair()
 	if err != nil {
 		return Error[T](err)
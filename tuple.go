@@ -0,0 +1,97 @@
+package sequence
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// A Triple is a 3 element tuple containing values of independant types.
+type Triple[A, B, C any] struct {
+	a A
+	b B
+	c C
+}
+
+// MakeTriple creates a triple using its three arguments.
+func MakeTriple[A, B, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{a: a, b: b, c: c}
+}
+
+// A returns the first value of the triple.
+func (t Triple[A, B, C]) A() A { return t.a }
+
+// B returns the second value of the triple.
+func (t Triple[A, B, C]) B() B { return t.b }
+
+// C returns the third value of the triple.
+func (t Triple[A, B, C]) C() C { return t.c }
+
+// ABC returns all values from the triple in order.
+func (t Triple[A, B, C]) ABC() (A, B, C) { return t.a, t.b, t.c }
+
+// String returns a string representation of this triple.
+func (t Triple[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.a, t.b, t.c)
+}
+
+// TripleCompare compares two Triple values with the same element types
+// provided all items in the triple are a cmp.Ordered type. Like with
+// [PairCompare], the elements are compared in order until one differs.
+func TripleCompare[A, B, C cmp.Ordered](a, b Triple[A, B, C]) int {
+	if c := cmp.Compare(a.a, b.a); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.b, b.b); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.c, b.c)
+}
+
+// A Quad is a 4 element tuple containing values of independant types.
+type Quad[A, B, C, D any] struct {
+	a A
+	b B
+	c C
+	d D
+}
+
+// MakeQuad creates a quad using its four arguments.
+func MakeQuad[A, B, C, D any](a A, b B, c C, d D) Quad[A, B, C, D] {
+	return Quad[A, B, C, D]{a: a, b: b, c: c, d: d}
+}
+
+// A returns the first value of the quad.
+func (q Quad[A, B, C, D]) A() A { return q.a }
+
+// B returns the second value of the quad.
+func (q Quad[A, B, C, D]) B() B { return q.b }
+
+// C returns the third value of the quad.
+func (q Quad[A, B, C, D]) C() C { return q.c }
+
+// D returns the fourth value of the quad.
+func (q Quad[A, B, C, D]) D() D { return q.d }
+
+// ABCD returns all values from the quad in order.
+func (q Quad[A, B, C, D]) ABCD() (A, B, C, D) { return q.a, q.b, q.c, q.d }
+
+// String returns a string representation of this quad.
+func (q Quad[A, B, C, D]) String() string {
+	return fmt.Sprintf("(%v, %v, %v, %v)", q.a, q.b, q.c, q.d)
+}
+
+// QuadCompare compares two Quad values with the same element types provided
+// all items in the quad are a cmp.Ordered type. Like with [PairCompare], the
+// elements are compared in order until one differs.
+func QuadCompare[A, B, C, D cmp.Ordered](a, b Quad[A, B, C, D]) int {
+	if c := cmp.Compare(a.a, b.a); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.b, b.b); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.c, b.c); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.d, b.d)
+}
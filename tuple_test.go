@@ -0,0 +1,41 @@
+package sequence
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestTupleCompare(t *testing.T) {
+	triples := New(
+		MakeTriple(2, "b", 1.0),
+		MakeTriple(1, "b", 2.0),
+		MakeTriple(2, "a", 3.0),
+		MakeTriple(2, "a", 0.5),
+	)
+	wantTriples := New(
+		MakeTriple(1, "b", 2.0),
+		MakeTriple(2, "a", 0.5),
+		MakeTriple(2, "a", 3.0),
+		MakeTriple(2, "b", 1.0),
+	)
+	compareSequences(t, triples.Sort(TripleCompare), wantTriples,
+		cmpopts.EquateComparable(Triple[int, string, float64]{}))
+
+	quads := New(
+		MakeQuad(1, 1, 1, "z"),
+		MakeQuad(1, 1, 1, "a"),
+		MakeQuad(0, 5, 5, "q"),
+	)
+	wantQuads := New(
+		MakeQuad(0, 5, 5, "q"),
+		MakeQuad(1, 1, 1, "a"),
+		MakeQuad(1, 1, 1, "z"),
+	)
+	compareSequences(t, quads.Sort(QuadCompare), wantQuads,
+		cmpopts.EquateComparable(Quad[int, int, int, string]{}))
+
+	if got, want := MakeQuad(1, "two", 3.0, 'x').String(), "(1, two, 3, 120)"; got != want {
+		t.Errorf("unexpected string; got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,152 @@
+package sequence
+
+import (
+	"fmt"
+	"time"
+)
+
+// BatchTimeout groups the items of the input sequence into batches of up to
+// maxSize items. A batch is passed on once it's full, or once maxWait has
+// passed since its first item arrived, whichever comes first, so a slow input
+// doesn't hold back the items already received. Any remaining items are
+// passed on when the input ends.
+//
+// A maxSize <= 0 means batches are only limited by time, and a maxWait <= 0
+// means batches are only limited by size.
+//
+// The input is iterated in a separate goroutine, which makes this useful in
+// front of live inputs such as [FromChan]. Since batch boundaries depend on
+// timing, the output is marked volatile like those from [AsyncPool] and
+// [Merge].
+func BatchTimeout[T any](s Sequence[T], maxSize int, maxWait time.Duration) Sequence[[]T] {
+	return BatchTimeoutClock(SystemClock, s, maxSize, maxWait)
+}
+
+// BatchTimeoutClock is like [BatchTimeout], but uses the given [Clock] for the
+// batch timer.
+func BatchTimeoutClock[T any](c Clock, s Sequence[T], maxSize int, maxWait time.Duration) Sequence[[]T] {
+	out := Derive(s, func(f func([]T) error) error {
+		done := make(chan struct{})
+		defer close(done)
+		in := feed(done, s)
+
+		var (
+			batch []T
+			timer <-chan time.Time
+		)
+		flush := func() error {
+			if len(batch) == 0 {
+				return nil
+			}
+			b := batch
+			batch, timer = nil, nil
+			return f(b)
+		}
+
+		for {
+			select {
+			case r, ok := <-in:
+				if !ok {
+					return flush()
+				}
+				if r.HasError() {
+					return r.Error()
+				}
+				if len(batch) == 0 && maxWait > 0 {
+					timer = c.After(maxWait)
+				}
+				batch = append(batch, r.Value())
+				if maxSize > 0 && len(batch) >= maxSize {
+					if err := flush(); err != nil {
+						return err
+					}
+				}
+			case <-timer:
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+	})
+	out.async = false
+	return Volatile(out)
+}
+
+// A Window is a group of items whose timestamps fall in the half-open interval
+// [Start, End).
+type Window[T any] struct {
+	Start, End time.Time
+	Items      []T
+}
+
+// String returns a string representation of the window.
+func (w Window[T]) String() string {
+	return fmt.Sprintf("[%v, %v): %v", w.Start, w.End, w.Items)
+}
+
+// TumblingWindows groups the items of the input sequence into consecutive,
+// non-overlapping windows of the given size, based on the timestamp returned
+// by ts for each item. Windows are aligned to multiples of size since the zero
+// time (see [time.Time.Truncate]), and only windows containing at least one
+// item are produced.
+//
+// Items are expected in timestamp order. A window is passed on as soon as an
+// item at or after its end arrives, so an item that arrives too late for its
+// window is dropped.
+func TumblingWindows[T any](s Sequence[T], size time.Duration, ts func(T) time.Time) Sequence[Window[T]] {
+	return SlidingWindows(s, size, size, ts)
+}
+
+// SlidingWindows groups the items of the input sequence into windows of the
+// given size, with a new window starting every step, based on the timestamp
+// returned by ts for each item. If step is smaller than size the windows
+// overlap and an item may appear in more than one of them, and if it's larger
+// there are gaps between windows where items are dropped.
+//
+// Windows are aligned to multiples of step since the zero time, and are
+// otherwise handled like those of [TumblingWindows].
+func SlidingWindows[T any](s Sequence[T], size, step time.Duration, ts func(T) time.Time) Sequence[Window[T]] {
+	if size <= 0 || step <= 0 {
+		return Error[Window[T]](fmt.Errorf("called SlidingWindows with invalid size (%v) or step (%v)", size, step))
+	}
+
+	src := s.Sync()
+	return Derive(src, func(f func(Window[T]) error) error {
+		var open []Window[T]
+		err := src.Each(func(item T) error {
+			t := ts(item)
+			for len(open) > 0 && !open[0].End.After(t) {
+				if err := f(open[0]); err != nil {
+					return err
+				}
+				open = open[1:]
+			}
+
+			var starts []time.Time
+			for start := t.Truncate(step); start.Add(size).After(t); start = start.Add(-step) {
+				starts = append(starts, start)
+			}
+			for i := len(starts) - 1; i >= 0; i-- {
+				if len(open) == 0 || starts[i].After(open[len(open)-1].Start) {
+					open = append(open, Window[T]{Start: starts[i], End: starts[i].Add(size)})
+				}
+			}
+
+			for i := range open {
+				if !open[i].Start.After(t) {
+					open[i].Items = append(open[i].Items, item)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, w := range open {
+			if err := f(w); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,113 @@
+package sequence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBatchTimeout(t *testing.T) {
+	start := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	c := NewFakeClock(start)
+
+	input := make(chan int)
+	batches := make(chan []int)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(batches)
+		errCh <- Each(BatchTimeoutClock(c, FromChan(input), 3, time.Second))(func(b []int) error {
+			batches <- b
+			return nil
+		})
+	}()
+
+	input <- 1
+	input <- 2
+	input <- 3
+	if diff := cmp.Diff(<-batches, []int{1, 2, 3}); diff != "" {
+		t.Errorf("unexpected full batch (-got, +want):\n%s", diff)
+	}
+
+	// The timer of the first batch is still pending, so wait for a second
+	// one to know the next item has arrived.
+	input <- 4
+	c.BlockUntil(2)
+	c.Advance(time.Second)
+	if diff := cmp.Diff(<-batches, []int{4}); diff != "" {
+		t.Errorf("unexpected timed out batch (-got, +want):\n%s", diff)
+	}
+
+	input <- 5
+	close(input)
+	if diff := cmp.Diff(<-batches, []int{5}); diff != "" {
+		t.Errorf("unexpected final batch (-got, +want):\n%s", diff)
+	}
+	if _, ok := <-batches; ok {
+		t.Error("unexpected extra batch")
+	}
+	if err := <-errCh; err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestBatchTimeoutSize(t *testing.T) {
+	batches := BatchTimeout(NumberSequence(0, 10, 1), 4, 0)
+	if !batches.IsVolatile() {
+		t.Error("batched sequence isn't volatile")
+	}
+	if batches.IsAsync() {
+		t.Error("batched sequence is async")
+	}
+	got := Map(batches, func(b []int) int { return len(b) })
+	compareSequences(t, got, New(4, 4, 2))
+
+	stopped := Limit(BatchTimeout(Counter(0), 2, time.Hour), 2)
+	wantStopped := New([]int{0, 1}, []int{2, 3})
+	if diff := cmp.Diff(stopped.ToSlice().Value(), wantStopped.ToSlice().Value()); diff != "" {
+		t.Errorf("unexpected batches (-got, +want):\n%s", diff)
+	}
+}
+
+func TestWindows(t *testing.T) {
+	base := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	at := func(sec int) time.Time { return base.Add(time.Duration(sec) * time.Second) }
+	ts := func(sec int) time.Time { return at(sec) }
+	input := New(0, 1, 4, 5, 6, 11, 12)
+
+	t.Run("Tumbling", func(t *testing.T) {
+		got := TumblingWindows(input, 5*time.Second, ts).ToSlice().Value()
+		want := []Window[int]{
+			{Start: at(0), End: at(5), Items: []int{0, 1, 4}},
+			{Start: at(5), End: at(10), Items: []int{5, 6}},
+			{Start: at(10), End: at(15), Items: []int{11, 12}},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("unexpected windows (-got, +want):\n%s", diff)
+		}
+	})
+
+	t.Run("Sliding", func(t *testing.T) {
+		got := SlidingWindows(input, 4*time.Second, 2*time.Second, ts).ToSlice().Value()
+		want := []Window[int]{
+			{Start: at(-2), End: at(2), Items: []int{0, 1}},
+			{Start: at(0), End: at(4), Items: []int{0, 1}},
+			{Start: at(2), End: at(6), Items: []int{4, 5}},
+			{Start: at(4), End: at(8), Items: []int{4, 5, 6}},
+			{Start: at(6), End: at(10), Items: []int{6}},
+			{Start: at(8), End: at(12), Items: []int{11}},
+			{Start: at(10), End: at(14), Items: []int{11, 12}},
+			{Start: at(12), End: at(16), Items: []int{12}},
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("unexpected windows (-got, +want):\n%s", diff)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		windows := SlidingWindows(input, time.Second, 0, ts)
+		if err := Each(windows)(func(Window[int]) error { return nil }); err == nil {
+			t.Error("expected error from invalid step")
+		}
+	})
+}
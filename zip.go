@@ -4,12 +4,12 @@ import (
 	"github.com/cookieo9/sequence/tools"
 )
 
-func zipCommon[A, B any](aSeq Sequence[A], bSeq Sequence[B], shortest bool) Sequence[Pair[A, B]] {
-	s := Generate(func(f func(Pair[A, B]) error) error {
+func zipCommon[A, B, Out any](aSeq Sequence[A], bSeq Sequence[B], shortest bool, combine func(A, B) Out) Sequence[Out] {
+	s := Generate(func(f func(Out) error) error {
 		aCh := make(chan Pair[A, error], 1)
-		go IntoChanPair(aCh, aSeq)
+		go IntoChanPair(aCh, Recover(aSeq))
 		bCh := make(chan Pair[B, error], 1)
-		go IntoChanPair(bCh, bSeq)
+		go IntoChanPair(bCh, Recover(bSeq))
 
 		for {
 			aP, aOk := <-aCh
@@ -26,7 +26,7 @@ func zipCommon[A, B any](aSeq Sequence[A], bSeq Sequence[B], shortest bool) Sequ
 			if err := tools.Or(aErr, bErr); err != nil {
 				return err
 			}
-			if err := f(MakePair(aV, bV)); err != nil {
+			if err := f(combine(aV, bV)); err != nil {
 				return err
 			}
 		}
@@ -43,15 +43,31 @@ func zipCommon[A, B any](aSeq Sequence[A], bSeq Sequence[B], shortest bool) Sequ
 // sequence. When one seqence ends, the zipped sequence stops.
 //
 // If at least one sequence is volatile then the output sequence will be as
-// well.
+// well. The inputs are iterated in their own goroutines, and a panic in either
+// of them is returned as a [*PanicError].
 func Zip[A, B any](aSeq Sequence[A], bSeq Sequence[B]) Sequence[Pair[A, B]] {
-	return zipCommon(aSeq, bSeq, true)
+	return zipCommon(aSeq, bSeq, true, MakePair[A, B])
 }
 
 // ZipLongest is like [Zip], but when the shorter sequence ends the output
 // uses a zero value in it's place while the longer sequence continues.
 func ZipLongest[A, B any](aSeq Sequence[A], bSeq Sequence[B]) Sequence[Pair[A, B]] {
-	return zipCommon(aSeq, bSeq, false)
+	return zipCommon(aSeq, bSeq, false, MakePair[A, B])
+}
+
+// ZipWith is like [Zip], but each item from the first sequence is combined
+// with the matching item from the second one using the given function, rather
+// than producing a Pair.
+func ZipWith[A, B, Out any](aSeq Sequence[A], bSeq Sequence[B], combine func(A, B) Out) Sequence[Out] {
+	return zipCommon(aSeq, bSeq, true, combine)
+}
+
+// Zip3 is like [Zip], but combines three sequences into a sequence of Triples.
+// When any of the sequences ends, the zipped sequence stops.
+func Zip3[A, B, C any](aSeq Sequence[A], bSeq Sequence[B], cSeq Sequence[C]) Sequence[Triple[A, B, C]] {
+	return ZipWith(Zip(aSeq, bSeq), cSeq, func(p Pair[A, B], c C) Triple[A, B, C] {
+		return MakeTriple(p.a, p.b, c)
+	})
 }
 
 // Unzip takes a sequence of pairs and returns two sequences, one containing
@@ -79,3 +95,12 @@ func PairSelectB[A, B any](s Sequence[Pair[A, B]]) Sequence[B] {
 func PairSwap[A, B any](s Sequence[Pair[A, B]]) Sequence[Pair[B, A]] {
 	return Map(s, Pair[A, B].Swap)
 }
+
+// Unzip3 takes a sequence of triples and returns three sequences, each
+// containing one of the items of each triple.
+func Unzip3[A, B, C any](s Sequence[Triple[A, B, C]]) (Sequence[A], Sequence[B], Sequence[C]) {
+	aS := Map(s, Triple[A, B, C].A)
+	bS := Map(s, Triple[A, B, C].B)
+	cS := Map(s, Triple[A, B, C].C)
+	return aS, bS, cS
+}
@@ -3,6 +3,7 @@ package sequence
 import (
 	"testing"
 
+	"github.com/cookieo9/sequence/tools"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 )
@@ -29,3 +30,31 @@ func TestZip(t *testing.T) {
 		t.Errorf("unexpected diff in Zip results (-got, +want):\n%s", diff)
 	}
 }
+
+func TestZipWith(t *testing.T) {
+	seq1 := New(1, 2, 3, 4)
+	seq2 := New(10, 20, 30)
+
+	compareSequences(t, ZipWith(seq1, seq2, tools.Add[int]), New(11, 22, 33))
+}
+
+func TestZip3(t *testing.T) {
+	seq1 := New(1, 2, 3, 4)
+	seq2 := New("one", "two", "three")
+	seq3 := New(1.5, 2.5, 3.5, 4.5)
+
+	opt := cmpopts.EquateComparable(Triple[int, string, float64]{})
+
+	zipped := Zip3(seq1, seq2, seq3)
+	want := New(
+		MakeTriple(1, "one", 1.5),
+		MakeTriple(2, "two", 2.5),
+		MakeTriple(3, "three", 3.5),
+	)
+	compareSequences(t, zipped, want, opt)
+
+	a, b, c := Unzip3(zipped)
+	compareSequences(t, a, seq1.Limit(3))
+	compareSequences(t, b, seq2)
+	compareSequences(t, c, seq3.Limit(3))
+}